@@ -1,11 +1,18 @@
 package main
 
 import (
+	"context"
+	"crypto/rsa"
 	"events/internal/config"
+	"events/internal/delivery/middleware"
 	routes "events/internal/delivery/routers"
+	"events/internal/domain"
+	"events/internal/notifier"
+	repositoryiface "events/internal/repository/interfaces"
 	repository "events/internal/repository/mongodb"
 	"events/internal/service"
 	"events/pkg/database"
+	"events/pkg/lib/jwt"
 	"events/pkg/lib/utils"
 	"events/pkg/logger"
 	"log/slog"
@@ -15,6 +22,7 @@ import (
 	"syscall"
 
 	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func main() {
@@ -26,33 +34,133 @@ func main() {
 	slog.Debug("Debug messages are enabled") // If env is set to prod, debug messages are going to be disabled
 
 	if err := database.InitDB(cfg); err != nil {
-		log.Error("Error setting up MongoDB: %v", err)
+		log.Error("Error setting up MongoDB", utils.Err(err))
 	}
 	defer database.Close()
 
+	db := database.GetDB()
+
+	verifier, err := newTokenVerifier(cfg.Auth)
+	if err != nil {
+		log.Error("Error loading token keys", utils.Err(err))
+		os.Exit(1)
+	}
+	if verifier == nil {
+		log.Warn("No token keys are configured, only API keys are accepted")
+	}
+
+	apiKeyRepository := repository.NewMongoDBAPIKeyRepository(db.Collection(cfg.MongoDB.APIKeyCollection))
+	if err := apiKeyRepository.EnsureIndexes(); err != nil {
+		log.Error("Error creating api key indexes", utils.Err(err))
+	}
+	roleRepository := repository.NewMongoDBRoleRepository(db.Collection(cfg.MongoDB.RoleCollection))
+	if err := roleRepository.EnsureIndexes(); err != nil {
+		log.Error("Error creating role assignment indexes", utils.Err(err))
+	}
+	authService := service.NewAuthService(verifier, apiKeyRepository, roleRepository, cfg.Auth.Admins)
+
 	mainRouter := chi.NewRouter()
+	mainRouter.Use(middleware.Authenticate(authService))
+	mainRouter.Use(middleware.CacheControl(cfg.Cache.DefaultPolicy, cfg.Cache.Routes))
 
-	movieRouter := chi.NewRouter()
+	authRouter := chi.NewRouter()
 
-	mainRouter.Route("/api/movie", func(r chi.Router) {
-		r.Mount("/", movieRouter)
+	mainRouter.Route("/api/auth", func(r chi.Router) {
+		r.Mount("/", authRouter)
 	})
 
-	movieCollection := database.GetDB().Collection("movies")
-	movieRepository := repository.NewMongoDBMovieRepository(movieCollection)
-	movieService := service.NewMovieService(movieRepository)
-	routes.SetupMovieRouter(movieRouter, movieService)
+	routes.SetupAuthRouter(authRouter, authService)
+
+	if cfg.Auth.HMACSecret == "" {
+		log.Warn("No HMAC secret is configured, user accounts are disabled")
+	} else {
+		setupUserRoutes(mainRouter, cfg, db)
+	}
+
+	showtimeCollection := db.Collection(cfg.MongoDB.ShowtimeCollection)
+	showtimeRepository := repository.NewMongoDBShowtimeRepository(showtimeCollection)
+	if err := showtimeRepository.EnsureIndexes(); err != nil {
+		log.Error("Error creating showtime indexes", utils.Err(err))
+	}
 
-	theatreRouter := chi.NewRouter()
+	auditRepository, err := repository.NewMongoDBAuditRepository(db.Collection(cfg.MongoDB.AuditCollection))
+	if err != nil {
+		log.Error("Error setting up the audit log", utils.Err(err))
+		os.Exit(1)
+	}
+	if err := auditRepository.EnsureIndexes(); err != nil {
+		log.Error("Error creating audit log indexes", utils.Err(err))
+	}
 
-	mainRouter.Route("/api/performance", func(r chi.Router) {
-		r.Mount("/", theatreRouter)
+	events := &eventRegistry{
+		routers:   map[domain.EventType]*chi.Mux{},
+		catalog:   repositoryiface.EventCatalog{},
+		feed:      repository.NewMongoDBFeedRepository(showtimeCollection),
+		showtimes: showtimeRepository,
+		audit:     auditRepository,
+		revisions: db.Collection(cfg.MongoDB.RevisionCollection),
+	}
+
+	setupEventRoutes[domain.Movie](mainRouter, db.Collection(cfg.MongoDB.MovieCollection), events)
+	setupEventRoutes[domain.Performance](mainRouter, db.Collection(cfg.MongoDB.TheatreCollection), events)
+	setupEventRoutes[domain.Concert](mainRouter, db.Collection(cfg.MongoDB.ConcertCollection), events)
+	setupEventRoutes[domain.Exhibition](mainRouter, db.Collection(cfg.MongoDB.ExhibitionCollection), events)
+	setupEventRoutes[domain.SportEvent](mainRouter, db.Collection(cfg.MongoDB.SportCollection), events)
+
+	feedRouter := chi.NewRouter()
+
+	mainRouter.Route("/api/events", func(r chi.Router) {
+		r.Mount("/", feedRouter)
 	})
 
-	theatreCollection := database.GetDB().Collection("theatre")
-	theatreRepository := repository.NewMongoDBTheatreRepository(theatreCollection)
-	theatreService := service.NewTheatreService(theatreRepository)
-	routes.SetupTheatreRouter(theatreRouter, theatreService)
+	feedService := service.NewFeedService(events.feed)
+	routes.SetupFeedRouter(feedRouter, feedService)
+
+	venueRouter := chi.NewRouter()
+
+	mainRouter.Route("/api/venue", func(r chi.Router) {
+		r.Mount("/", venueRouter)
+	})
+
+	venueCollection := database.GetDB().Collection(cfg.MongoDB.VenueCollection)
+	venueRepository := repository.NewMongoDBVenueRepository(venueCollection)
+	if err := venueRepository.EnsureIndexes(); err != nil {
+		log.Error("Error creating venue indexes", utils.Err(err))
+	}
+	hallCollection := database.GetDB().Collection(cfg.MongoDB.HallCollection)
+	hallRepository := repository.NewMongoDBHallRepository(hallCollection)
+	if err := hallRepository.EnsureIndexes(); err != nil {
+		log.Error("Error creating hall indexes", utils.Err(err))
+	}
+	venueService := service.NewVenueService(venueRepository, hallRepository)
+	routes.SetupVenueRouter(venueRouter, venueService)
+
+	showtimeRouter := chi.NewRouter()
+
+	mainRouter.Route("/api/showtimes", func(r chi.Router) {
+		r.Mount("/", showtimeRouter)
+	})
+
+	showtimeService := service.NewShowtimeService(showtimeRepository, hallRepository, events.catalog)
+	routes.SetupShowtimeRouter(showtimeRouter, events.routers, showtimeService)
+
+	bookingRouter := chi.NewRouter()
+
+	mainRouter.Route("/api/bookings", func(r chi.Router) {
+		r.Mount("/", bookingRouter)
+	})
+
+	bookingCollection := database.GetDB().Collection(cfg.MongoDB.BookingCollection)
+	seatLockCollection := database.GetDB().Collection(cfg.MongoDB.SeatLockCollection)
+	bookingRepository := repository.NewMongoDBBookingRepository(bookingCollection, seatLockCollection)
+	if err := bookingRepository.EnsureIndexes(); err != nil {
+		log.Error("Error creating booking indexes", utils.Err(err))
+	}
+	bookingService := service.NewBookingService(bookingRepository, showtimeRepository, hallRepository, events.catalog, cfg.Booking.HoldTTL)
+	routes.SetupBookingRouter(bookingRouter, showtimeRouter, bookingService)
+
+	go service.RunTrashPurge(context.Background(), events.purgers, cfg.Trash.Retention, cfg.Trash.PurgeInterval)
+	go service.RunStatusScheduler(context.Background(), events.schedulers, cfg.Publishing.SchedulerInterval)
 
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
@@ -69,3 +177,139 @@ func main() {
 		slog.Error("Server failed to start:", utils.Err(err))
 	}
 }
+
+// newTokenVerifier returns a verifier for the configured token keys, or nil
+// when there are none.
+func newTokenVerifier(cfg config.Auth) (*jwt.Verifier, error) {
+	keys := jwt.Keys{
+		HMAC: []byte(cfg.HMACSecret),
+		RSA:  map[string]*rsa.PublicKey{},
+	}
+
+	if cfg.JWKSFile != "" {
+		data, err := os.ReadFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		if keys.RSA, err = jwt.ParseJWKS(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.RSAPublicKeyFile != "" {
+		data, err := os.ReadFile(cfg.RSAPublicKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		key, err := jwt.ParseRSAPublicKey(data)
+		if err != nil {
+			return nil, err
+		}
+		// Tokens without a key ID are verified with this key.
+		keys.RSA[""] = key
+	}
+
+	if keys.IsEmpty() {
+		return nil, nil
+	}
+
+	return jwt.NewVerifier(keys, cfg.Issuer, cfg.Audience, cfg.Leeway), nil
+}
+
+// setupUserRoutes mounts the account routes under /api/users. The access
+// tokens they issue are signed with the HMAC secret, so the verifier accepts
+// them.
+func setupUserRoutes(mainRouter *chi.Mux, cfg *config.Config, db *mongo.Database) {
+	userRouter := chi.NewRouter()
+
+	mainRouter.Route("/api/users", func(r chi.Router) {
+		r.Mount("/", userRouter)
+	})
+
+	userRepository := repository.NewMongoDBUserRepository(db.Collection(cfg.MongoDB.UserCollection))
+	if err := userRepository.EnsureIndexes(); err != nil {
+		slog.Error("Error creating user indexes", utils.Err(err))
+	}
+	userTokenRepository := repository.NewMongoDBUserTokenRepository(db.Collection(cfg.MongoDB.UserTokenCollection))
+	if err := userTokenRepository.EnsureIndexes(); err != nil {
+		slog.Error("Error creating user token indexes", utils.Err(err))
+	}
+
+	userNotifier, err := notifier.New(cfg.Notifier.Kind, cfg.Notifier.File)
+	if err != nil {
+		slog.Error("Error setting up the notifier", utils.Err(err))
+		os.Exit(1)
+	}
+
+	userService := service.NewUserService(userRepository, userTokenRepository, userNotifier, service.UserSettings{
+		TokenSecret:       []byte(cfg.Auth.HMACSecret),
+		Issuer:            cfg.Auth.Issuer,
+		Audience:          cfg.Auth.Audience,
+		AccessTokenTTL:    cfg.Users.AccessTokenTTL,
+		RefreshTokenTTL:   cfg.Users.RefreshTokenTTL,
+		ResetTokenTTL:     cfg.Users.ResetTokenTTL,
+		MinPasswordLength: cfg.Users.MinPasswordLength,
+		PasswordCost:      cfg.Users.PasswordCost,
+		ResetURL:          cfg.Users.ResetURL,
+	})
+	routes.SetupUserRouter(userRouter, userService)
+}
+
+// eventRegistry collects what other modules need to know about every event
+// kind: its router, its finder and its place in the feed and the showtime
+// listings, along with the stores and jobs all kinds share.
+type eventRegistry struct {
+	routers    map[domain.EventType]*chi.Mux
+	catalog    repositoryiface.EventCatalog
+	feed       *repository.MongoDBFeedRepository
+	showtimes  *repository.MongoDBShowtimeRepository
+	audit      repositoryiface.AuditRepository
+	revisions  *mongo.Collection
+	purgers    []service.TrashPurger
+	schedulers []service.StatusScheduler
+}
+
+// setupEventRoutes mounts the routes of one event kind under /api/<type>,
+// backed by the given collection, and adds the kind to the registry.
+func setupEventRoutes[T any, PT domain.EventPtr[T]](mainRouter *chi.Mux, collection *mongo.Collection, events *eventRegistry) {
+	eventType := PT(new(T)).Kind().Type
+
+	eventRouter := chi.NewRouter()
+
+	mainRouter.Route("/api/"+string(eventType), func(r chi.Router) {
+		r.Mount("/", eventRouter)
+	})
+
+	eventRepository := repository.NewMongoDBEventRepository[T, PT](collection)
+	if err := eventRepository.EnsureIndexes(); err != nil {
+		slog.Error("Error creating event indexes", slog.String("eventType", string(eventType)), utils.Err(err))
+	}
+	if err := eventRepository.MigrateLegacyFields(); err != nil {
+		slog.Error("Error migrating event legacy fields", slog.String("eventType", string(eventType)), utils.Err(err))
+	}
+	if err := eventRepository.BackfillVersions(); err != nil {
+		slog.Error("Error backfilling event versions", slog.String("eventType", string(eventType)), utils.Err(err))
+	}
+	if err := eventRepository.BackfillStatuses(); err != nil {
+		slog.Error("Error backfilling event statuses", slog.String("eventType", string(eventType)), utils.Err(err))
+	}
+	if err := eventRepository.BackfillTimestamps(); err != nil {
+		slog.Error("Error backfilling event timestamps", slog.String("eventType", string(eventType)), utils.Err(err))
+	}
+	if err := eventRepository.BackfillSearchNames(); err != nil {
+		slog.Error("Error backfilling event search names", slog.String("eventType", string(eventType)), utils.Err(err))
+	}
+	revisionRepository := repository.NewMongoDBRevisionRepository[T, PT](events.revisions)
+	if err := revisionRepository.EnsureIndexes(); err != nil {
+		slog.Error("Error creating revision indexes", slog.String("eventType", string(eventType)), utils.Err(err))
+	}
+	eventService := service.NewEventService[T, PT](eventRepository, events.audit, revisionRepository, events.showtimes)
+	routes.SetupEventRouter(eventRouter, eventService)
+
+	events.routers[eventType] = eventRouter
+	events.catalog[eventType] = eventRepository
+	events.feed.AddSource(eventRepository)
+	events.showtimes.AddEventSource(eventRepository)
+	events.purgers = append(events.purgers, eventService)
+	events.schedulers = append(events.schedulers, eventService)
+}
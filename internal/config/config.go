@@ -3,14 +3,22 @@ package config
 import (
 	"events/pkg/lib/utils"
 	"log"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type Config struct {
-	Env     string  `yaml:"env"`
-	Server  Server  `yaml:"server"`
-	MongoDB MongoDB `yaml:"mongodb"`
+	Env        string     `yaml:"env"`
+	Server     Server     `yaml:"server"`
+	MongoDB    MongoDB    `yaml:"mongodb"`
+	Booking    Booking    `yaml:"booking"`
+	Cache      Cache      `yaml:"cache"`
+	Trash      Trash      `yaml:"trash"`
+	Publishing Publishing `yaml:"publishing"`
+	Auth       Auth       `yaml:"auth"`
+	Users      Users      `yaml:"users"`
+	Notifier   Notifier   `yaml:"notifier"`
 }
 
 type Server struct {
@@ -18,10 +26,81 @@ type Server struct {
 }
 
 type MongoDB struct {
-	URI               string `yaml:"uri"`
-	Database          string `yaml:"database"`
-	MovieCollection   string `yaml:"movieCollection"`
-	TheatreCollection string `yaml:"theatreCollection"`
+	URI                  string `yaml:"uri"`
+	Database             string `yaml:"database"`
+	MovieCollection      string `yaml:"movieCollection" env-default:"movies"`
+	TheatreCollection    string `yaml:"theatreCollection" env-default:"theatre"`
+	ConcertCollection    string `yaml:"concertCollection" env-default:"concerts"`
+	ExhibitionCollection string `yaml:"exhibitionCollection" env-default:"exhibitions"`
+	SportCollection      string `yaml:"sportCollection" env-default:"sports"`
+	ShowtimeCollection   string `yaml:"showtimeCollection" env-default:"showtimes"`
+	VenueCollection      string `yaml:"venueCollection" env-default:"venues"`
+	HallCollection       string `yaml:"hallCollection" env-default:"halls"`
+	BookingCollection    string `yaml:"bookingCollection" env-default:"bookings"`
+	SeatLockCollection   string `yaml:"seatLockCollection" env-default:"seat_locks"`
+	AuditCollection      string `yaml:"auditCollection" env-default:"audit_log"`
+	RevisionCollection   string `yaml:"revisionCollection" env-default:"revisions"`
+	APIKeyCollection     string `yaml:"apiKeyCollection" env-default:"api_keys"`
+	RoleCollection       string `yaml:"roleCollection" env-default:"role_assignments"`
+	UserCollection       string `yaml:"userCollection" env-default:"users"`
+	UserTokenCollection  string `yaml:"userTokenCollection" env-default:"user_tokens"`
+}
+
+type Booking struct {
+	HoldTTL time.Duration `yaml:"holdTTL" env-default:"10m"`
+}
+
+// Cache holds the Cache-Control policies of GET responses. Routes maps chi
+// route patterns, e.g. "/api/movie/{id}", to their own policy.
+type Cache struct {
+	DefaultPolicy string            `yaml:"defaultPolicy" env-default:"no-cache"`
+	Routes        map[string]string `yaml:"routes"`
+}
+
+// Trash holds how long deleted items stay restorable and how often the ones
+// past that are purged.
+type Trash struct {
+	Retention     time.Duration `yaml:"retention" env-default:"720h"`
+	PurgeInterval time.Duration `yaml:"purgeInterval" env-default:"1h"`
+}
+
+// Publishing holds how often scheduled publication changes are applied.
+type Publishing struct {
+	SchedulerInterval time.Duration `yaml:"schedulerInterval" env-default:"1m"`
+}
+
+// Auth holds the keys bearer tokens are verified with: an HMAC secret for
+// HS256, and for RS256 a PEM public key file, a JWKS file, or both. Issuer and
+// Audience are checked when set. Without any key only API keys are
+// accepted. Admins are subjects that are admins without a role assignment.
+type Auth struct {
+	HMACSecret       string        `yaml:"hmacSecret" env:"AUTH_HMAC_SECRET"`
+	RSAPublicKeyFile string        `yaml:"rsaPublicKeyFile" env:"AUTH_RSA_PUBLIC_KEY_FILE"`
+	JWKSFile         string        `yaml:"jwksFile" env:"AUTH_JWKS_FILE"`
+	Issuer           string        `yaml:"issuer" env:"AUTH_ISSUER"`
+	Audience         string        `yaml:"audience" env:"AUTH_AUDIENCE"`
+	Leeway           time.Duration `yaml:"leeway" env-default:"1m"`
+	Admins           []string      `yaml:"admins" env:"AUTH_ADMINS" env-separator:","`
+}
+
+// Users holds the lifetimes of the tokens issued to users and the password
+// policy. Access tokens are signed with the HMAC secret of Auth, and user
+// accounts are only served when it is set. ResetURL, when set, is the link
+// password reset tokens are appended to in notifications.
+type Users struct {
+	AccessTokenTTL    time.Duration `yaml:"accessTokenTTL" env-default:"15m"`
+	RefreshTokenTTL   time.Duration `yaml:"refreshTokenTTL" env-default:"720h"`
+	ResetTokenTTL     time.Duration `yaml:"resetTokenTTL" env-default:"1h"`
+	MinPasswordLength int           `yaml:"minPasswordLength" env-default:"8"`
+	PasswordCost      int           `yaml:"passwordCost" env-default:"10"`
+	ResetURL          string        `yaml:"resetURL"`
+}
+
+// Notifier picks how messages reach users: "log" writes them to the
+// application log, "file" appends them to File.
+type Notifier struct {
+	Kind string `yaml:"kind" env-default:"log"`
+	File string `yaml:"file" env-default:"notifications.log"`
 }
 
 func LoadConfig() *Config {
@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"events/internal/domain"
+	service "events/internal/service/interfaces"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/pagination"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuthHandler struct {
+	AuthService service.AuthService
+	Router      *chi.Mux
+}
+
+// MeHandler returns the caller the request was authenticated as.
+func (h *AuthHandler) MeHandler(w http.ResponseWriter, r *http.Request) {
+	utils.RespondWithJSON(w, status.OK, domain.PrincipalFrom(r.Context()))
+}
+
+func (h *AuthHandler) GetAPIKeysHandler(w http.ResponseWriter, r *http.Request) {
+	keys, err := h.AuthService.GetAPIKeys()
+	if err != nil {
+		respondWithAuthError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, map[string]interface{}{"apiKeys": keys})
+}
+
+func (h *AuthHandler) CreateAPIKeyHandler(w http.ResponseWriter, r *http.Request) {
+	var request domain.CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	request.Name = strings.TrimSpace(request.Name)
+	if request.Name == "" {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.MissingAPIKeyName)
+		return
+	}
+
+	key, err := h.AuthService.CreateAPIKey(r.Context(), &request)
+	if err != nil {
+		respondWithAuthError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, key)
+}
+
+func (h *AuthHandler) RevokeAPIKeyHandler(w http.ResponseWriter, r *http.Request) {
+	keyID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidAPIKeyID)
+		return
+	}
+
+	if err := h.AuthService.RevokeAPIKey(r.Context(), keyID); err != nil {
+		respondWithAuthError(w, err)
+		return
+	}
+
+	response := StatusMessage{
+		Code:    200,
+		Message: "API key revoked successfully",
+	}
+
+	utils.RespondWithJSON(w, status.OK, response)
+}
+
+func (h *AuthHandler) GetRoleAssignmentsHandler(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	total, err := h.AuthService.GetRoleAssignmentCount()
+	if err != nil {
+		respondWithAuthError(w, err)
+		return
+	}
+
+	assignments, err := h.AuthService.GetRoleAssignments(page, pageSize)
+	if err != nil {
+		respondWithAuthError(w, err)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"roles":      assignments,
+		"pagination": pagination.New(page, pageSize, total),
+	}
+
+	utils.RespondWithJSON(w, status.OK, responseData)
+}
+
+// AssignRoleHandler grants a role to a subject, for the listed event types
+// or for the whole catalog.
+func (h *AuthHandler) AssignRoleHandler(w http.ResponseWriter, r *http.Request) {
+	var assignment domain.RoleAssignment
+	if err := json.NewDecoder(r.Body).Decode(&assignment); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	created, err := h.AuthService.AssignRole(r.Context(), &assignment)
+	if err != nil {
+		respondWithAuthError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, created)
+}
+
+func (h *AuthHandler) RevokeRoleHandler(w http.ResponseWriter, r *http.Request) {
+	assignmentID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRoleID)
+		return
+	}
+
+	if err := h.AuthService.RevokeRole(r.Context(), assignmentID); err != nil {
+		respondWithAuthError(w, err)
+		return
+	}
+
+	response := StatusMessage{
+		Code:    200,
+		Message: "Role assignment revoked successfully",
+	}
+
+	utils.RespondWithJSON(w, status.OK, response)
+}
+
+func respondWithAuthError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrAPIKeyNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.APIKeyNotFound)
+	case errors.Is(err, domain.ErrRoleNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.RoleNotFound)
+	case errors.Is(err, domain.ErrInvalidRole):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRole)
+	case errors.Is(err, domain.ErrForbidden):
+		utils.RespondWithErrorJSON(w, status.Forbidden, errs.Forbidden)
+	default:
+		slog.Error("Error handling auth request: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+	}
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"events/internal/delivery/middleware"
+	"events/internal/domain"
+	service "events/internal/service/interfaces"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type BookingHandler struct {
+	BookingService service.BookingService
+	Router         *chi.Mux
+}
+
+func (h *BookingHandler) GetSeatAvailabilityHandler(w http.ResponseWriter, r *http.Request) {
+	showtimeID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidShowtimeID)
+		return
+	}
+
+	availability, err := h.BookingService.GetSeatAvailability(showtimeID)
+	if err != nil {
+		respondWithBookingError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, availability)
+}
+
+func (h *BookingHandler) GetBookingHandler(w http.ResponseWriter, r *http.Request) {
+	bookingID, token, ok := parseBookingAccess(w, r)
+	if !ok {
+		return
+	}
+
+	booking, err := h.BookingService.GetBookingByID(bookingID, token)
+	if err != nil {
+		respondWithBookingError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, booking)
+}
+
+func (h *BookingHandler) HoldSeatsHandler(w http.ResponseWriter, r *http.Request) {
+	var createBookingRequest domain.CreateBookingRequest
+	if err := json.NewDecoder(r.Body).Decode(&createBookingRequest); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	booking, err := h.BookingService.HoldSeats(&createBookingRequest)
+	if err != nil {
+		respondWithBookingError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, booking)
+}
+
+func (h *BookingHandler) ConfirmBookingHandler(w http.ResponseWriter, r *http.Request) {
+	bookingID, token, ok := parseBookingAccess(w, r)
+	if !ok {
+		return
+	}
+
+	booking, err := h.BookingService.ConfirmBooking(bookingID, token)
+	if err != nil {
+		respondWithBookingError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, booking)
+}
+
+func (h *BookingHandler) CancelBookingHandler(w http.ResponseWriter, r *http.Request) {
+	bookingID, token, ok := parseBookingAccess(w, r)
+	if !ok {
+		return
+	}
+
+	booking, err := h.BookingService.CancelBooking(bookingID, token)
+	if err != nil {
+		respondWithBookingError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, booking)
+}
+
+// parseBookingAccess reads the booking ID from the path and its access token
+// from the X-Booking-Token header.
+func parseBookingAccess(w http.ResponseWriter, r *http.Request) (primitive.ObjectID, string, bool) {
+	bookingID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidBookingID)
+		return primitive.NilObjectID, "", false
+	}
+
+	token := r.Header.Get(middleware.BookingTokenHeader)
+	if token == "" {
+		utils.RespondWithErrorJSON(w, status.Unauthorized, errs.MissingBookingToken)
+		return primitive.NilObjectID, "", false
+	}
+
+	return bookingID, token, true
+}
+
+func respondWithBookingError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrBookingNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.BookingNotFound)
+	case errors.Is(err, domain.ErrShowtimeNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.ShowtimeNotFound)
+	case errors.Is(err, domain.ErrHallNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.HallNotFound)
+	case errors.Is(err, domain.ErrInvalidSeats):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidSeats)
+	case errors.Is(err, domain.ErrSeatsUnavailable):
+		utils.RespondWithErrorJSON(w, status.Conflict, errs.SeatsUnavailable)
+	case errors.Is(err, domain.ErrShowtimeNotBookable):
+		utils.RespondWithErrorJSON(w, status.Conflict, errs.ShowtimeNotBookable)
+	case errors.Is(err, domain.ErrHoldExpired):
+		utils.RespondWithErrorJSON(w, status.Conflict, errs.HoldExpired)
+	case errors.Is(err, domain.ErrBookingNotHeld):
+		utils.RespondWithErrorJSON(w, status.Conflict, errs.BookingNotHeld)
+	case errors.Is(err, domain.ErrBookingNotCancellable):
+		utils.RespondWithErrorJSON(w, status.Conflict, errs.BookingNotCancelable)
+	default:
+		slog.Error("Error handling booking request: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+	}
+}
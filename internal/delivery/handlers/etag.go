@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// setETag sends a version as a strong entity tag, e.g. "3".
+func setETag(w http.ResponseWriter, version int) {
+	if version > 0 {
+		w.Header().Set("ETag", strconv.Quote(strconv.Itoa(version)))
+	}
+}
+
+// parseIfMatch reads the version a write is conditional on. A missing
+// If-Match or "*" allows any version and yields zero. A tag that cannot be
+// one of ours never matches, so it is answered with 412 and false.
+func parseIfMatch(w http.ResponseWriter, r *http.Request) (int, bool) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return 0, true
+	}
+
+	tag, err := strconv.Unquote(value)
+	version, convErr := strconv.Atoi(tag)
+	if err != nil || convErr != nil || version < 1 {
+		utils.RespondWithErrorJSON(w, status.PreconditionFailed, errs.VersionMismatch)
+		return 0, false
+	}
+
+	return version, true
+}
+
+// respondWithVersionedJSON serves a single resource with its version as the
+// ETag and its update time as Last-Modified, answering 304 when the client's
+// copy is current.
+func respondWithVersionedJSON(w http.ResponseWriter, r *http.Request, data interface{}, version int, modified time.Time) {
+	setETag(w, version)
+	if !modified.IsZero() {
+		w.Header().Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(r, w.Header().Get("ETag"), modified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, data)
+}
+
+// respondWithListJSON serves a list with a weak ETag over its encoding.
+// Lists have no Last-Modified, a deleted item would not move it.
+func respondWithListJSON(w http.ResponseWriter, r *http.Request, data interface{}) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		slog.Error("Error encoding list response: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `W/"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+
+	if notModified(r, etag, time.Time{}) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status.OK)
+	w.Write(body)
+}
+
+// notModified evaluates If-None-Match, or If-Modified-Since when no
+// If-None-Match is sent, for GET and HEAD requests.
+func notModified(r *http.Request, etag string, modified time.Time) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return false
+	}
+
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		return etag != "" && etagListMatches(header, etag)
+	}
+
+	if header := r.Header.Get("If-Modified-Since"); header != "" && !modified.IsZero() {
+		since, err := http.ParseTime(header)
+		return err == nil && !modified.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
+
+// etagListMatches compares each tag of an If-None-Match list with etag
+// using the weak comparison RFC 9110 prescribes for it.
+func etagListMatches(header, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"events/internal/domain"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// parseEventFilter reads the filter query parameters of the list endpoints:
+//
+//	tags_all, tags_any, tags_none                  repeatable or comma separated
+//	categories_all, categories_any, categories_none
+//	release_from, release_to                        RFC 3339 or plain dates, to is exclusive
+//	duration_min, duration_max                      minutes, inclusive
+//	age_min, age_max                                minimum age ratings, inclusive
+//	has_media                                       true or false
+//	status                                          publication statuses, published when omitted
+//
+// Other parameters are left to the caller.
+func parseEventFilter(values url.Values) (domain.EventFilter, error) {
+	filter := domain.EventFilter{
+		TagsAll:        listParam(values, "tags_all"),
+		TagsAny:        listParam(values, "tags_any"),
+		TagsNone:       listParam(values, "tags_none"),
+		CategoriesAll:  listParam(values, "categories_all"),
+		CategoriesAny:  listParam(values, "categories_any"),
+		CategoriesNone: listParam(values, "categories_none"),
+	}
+
+	for _, status := range listParam(values, "status") {
+		filter.Statuses = append(filter.Statuses, domain.PublicationStatus(status))
+	}
+
+	if value := values.Get("release_from"); value != "" {
+		from, err := parseTime(value)
+		if err != nil {
+			return domain.EventFilter{}, err
+		}
+		filter.ReleaseFrom = &from
+	}
+
+	if value := values.Get("release_to"); value != "" {
+		to, err := parseTime(value)
+		if err != nil {
+			return domain.EventFilter{}, err
+		}
+		filter.ReleaseTo = &to
+	}
+
+	for _, param := range []struct {
+		key   string
+		bound **int
+	}{
+		{"duration_min", &filter.DurationMin},
+		{"duration_max", &filter.DurationMax},
+		{"age_min", &filter.AgeMin},
+		{"age_max", &filter.AgeMax},
+	} {
+		if value := values.Get(param.key); value != "" {
+			bound, err := strconv.Atoi(value)
+			if err != nil {
+				return domain.EventFilter{}, err
+			}
+			*param.bound = &bound
+		}
+	}
+
+	if value := values.Get("has_media"); value != "" {
+		hasMedia, err := strconv.ParseBool(value)
+		if err != nil {
+			return domain.EventFilter{}, err
+		}
+		filter.HasMedia = &hasMedia
+	}
+
+	return filter, nil
+}
+
+// listParam collects the values of a repeatable parameter, also splitting
+// comma separated values.
+func listParam(values url.Values, key string) []string {
+	var list []string
+	for _, value := range values[key] {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+	}
+
+	return list
+}
@@ -0,0 +1,676 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"events/internal/domain"
+	service "events/internal/service/interfaces"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/pagination"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EventHandler serves the CRUD, search and tag filter endpoints of one event
+// kind. Response keys and messages are taken from the kind.
+type EventHandler[T any, PT domain.EventPtr[T]] struct {
+	EventService service.EventService[T]
+	Router       *chi.Mux
+}
+
+const (
+	defaultSuggestLimit = 8
+	maxSuggestLimit     = 20
+
+	mergePatchMediaType = "application/merge-patch+json"
+	maxPatchSize        = 1 << 20
+)
+
+type StatusMessage struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetAllHandler lists events narrowed by the filter query parameters, see
+// parseEventFilter, in the order given by sort.
+func (h *EventHandler[T, PT]) GetAllHandler(w http.ResponseWriter, r *http.Request) {
+	filter, err := parseEventFilter(r.URL.Query())
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidFilter)
+		return
+	}
+
+	h.respondWithList(w, r, filter)
+}
+
+// QueryHandler lists events narrowed by a filter sent as a JSON body, for
+// filters too long for a URL. Pagination stays in the query string.
+func (h *EventHandler[T, PT]) QueryHandler(w http.ResponseWriter, r *http.Request) {
+	var filter domain.EventFilter
+
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&filter); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidFilter)
+		return
+	}
+
+	h.respondWithList(w, r, filter)
+}
+
+func (h *EventHandler[T, PT]) respondWithList(w http.ResponseWriter, r *http.Request, filter domain.EventFilter) {
+	if !h.canSeeStatuses(r, filter.Statuses) {
+		respondWithForbidden(w, r)
+		return
+	}
+
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	kind := h.kind()
+
+	sort, ok := parseSortParam(w, r, kind.SortFields())
+	if !ok {
+		return
+	}
+
+	if isCursorRequest(r) {
+		respondWithCursorPage(w, r, kind.Plural, pageSize, func(after primitive.ObjectID, limit int) ([]*T, error) {
+			return h.EventService.GetAllAfter(filter, after, limit)
+		}, eventCursorID[T, PT])
+		return
+	}
+
+	total, err := h.EventService.GetTotalCount(filter)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	events, err := h.EventService.GetAll(filter, sort, page, pageSize)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		kind.Plural:  events,
+		"pagination": pagination.New(page, pageSize, total),
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
+
+func (h *EventHandler[T, PT]) GetByIDHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	event, err := h.EventService.GetByID(eventID)
+	if err != nil {
+		slog.Error("Error getting event by ID: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	// Callers without the read permission can't tell unpublished events
+	// from missing ones.
+	if event == nil || !h.canSeeStatuses(r, []domain.PublicationStatus{PT(event).Base().Status}) {
+		utils.RespondWithErrorJSON(w, status.NotFound, h.notFoundMessage())
+		return
+	}
+
+	base := PT(event).Base()
+	respondWithVersionedJSON(w, r, event, base.Version, base.UpdatedAt)
+}
+
+// canSeeStatuses reports whether the caller may see events in the given
+// statuses. Only published events are public, the others take the read
+// permission for this kind.
+func (h *EventHandler[T, PT]) canSeeStatuses(r *http.Request, statuses []domain.PublicationStatus) bool {
+	if domain.PrincipalFrom(r.Context()).Can(domain.PermissionRead, h.kind().Type) {
+		return true
+	}
+
+	for _, status := range statuses {
+		if status != domain.StatusPublished {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (h *EventHandler[T, PT]) CreateHandler(w http.ResponseWriter, r *http.Request) {
+	var request T
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	event, err := h.EventService.Create(r.Context(), &request)
+	if respondWithValidationError(w, err) {
+		return
+	}
+	if errors.Is(err, domain.ErrForbidden) {
+		respondWithForbidden(w, r)
+		return
+	}
+	if err != nil {
+		slog.Error("Error creating event: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, fmt.Sprintf("Error creating %s: %v", strings.ToLower(h.kind().Name), err))
+		return
+	}
+
+	setETag(w, PT(event).Base().Version)
+	utils.RespondWithJSON(w, http.StatusCreated, event)
+}
+
+func (h *EventHandler[T, PT]) UpdateHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	version, ok := parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var request T
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	event, err := h.EventService.Update(r.Context(), eventID, &request, version)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	setETag(w, PT(event).Base().Version)
+	utils.RespondWithJSON(w, status.OK, event)
+}
+
+// PatchHandler applies an RFC 7396 merge patch: only the fields present in
+// the body change, and null removes a field's value.
+func (h *EventHandler[T, PT]) PatchHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType != "" &&
+		mediaType != mergePatchMediaType && mediaType != "application/json" {
+		utils.RespondWithErrorJSON(w, status.UnsupportedMediaType, errs.UnsupportedPatch)
+		return
+	}
+
+	version, ok := parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	patch, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPatchSize))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	event, err := h.EventService.Patch(r.Context(), eventID, patch, version)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	setETag(w, PT(event).Base().Version)
+	utils.RespondWithJSON(w, status.OK, event)
+}
+
+func (h *EventHandler[T, PT]) DeleteHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	version, ok := parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.EventService.Delete(r.Context(), eventID, version); err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	response := StatusMessage{
+		Code:    200,
+		Message: h.kind().Name + " deleted successfully",
+	}
+
+	utils.RespondWithJSON(w, status.OK, response)
+}
+
+func (h *EventHandler[T, PT]) SearchHandler(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("query")
+
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	kind := h.kind()
+
+	sort, ok := parseSortParam(w, r, kind.SortFields())
+	if !ok {
+		return
+	}
+
+	if isCursorRequest(r) {
+		respondWithCursorPage(w, r, kind.Plural, pageSize, func(after primitive.ObjectID, limit int) ([]*T, error) {
+			return h.EventService.SearchAfter(query, after, limit)
+		}, eventCursorID[T, PT])
+		return
+	}
+
+	total, err := h.EventService.GetSearchCount(query)
+	if err != nil {
+		slog.Error("Error getting search "+kind.Plural+" count: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	events, err := h.EventService.Search(query, sort, page, pageSize)
+	if err != nil {
+		slog.Error("Error searching "+kind.Plural+": ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		kind.Plural:  events,
+		"pagination": pagination.New(page, pageSize, total),
+	}
+
+	if wantsFacets(r) {
+		facets, err := h.EventService.GetSearchFacets(query)
+		if err != nil {
+			slog.Error("Error getting search "+kind.Plural+" facets: ", utils.Err(err))
+			utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+			return
+		}
+		responseData["facets"] = facets
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
+
+func (h *EventHandler[T, PT]) FilterByTagsHandler(w http.ResponseWriter, r *http.Request) {
+	queryTags := r.URL.Query()["tags"]
+	if len(queryTags) == 0 {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.MissingTags)
+		return
+	}
+
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	kind := h.kind()
+
+	sort, ok := parseSortParam(w, r, kind.SortFields())
+	if !ok {
+		return
+	}
+
+	if isCursorRequest(r) {
+		respondWithCursorPage(w, r, kind.Plural, pageSize, func(after primitive.ObjectID, limit int) ([]*T, error) {
+			return h.EventService.FilterByTagsAfter(queryTags, after, limit)
+		}, eventCursorID[T, PT])
+		return
+	}
+
+	total, err := h.EventService.GetFilteredCount(queryTags)
+	if err != nil {
+		slog.Error("Error getting filtered "+kind.Plural+" count: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	events, err := h.EventService.FilterByTags(queryTags, sort, page, pageSize)
+	if err != nil {
+		slog.Error("Error filtering "+kind.Plural+" by tags: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		kind.Plural:  events,
+		"pagination": pagination.New(page, pageSize, total),
+	}
+
+	if wantsFacets(r) {
+		facets, err := h.EventService.GetFilteredFacets(queryTags)
+		if err != nil {
+			slog.Error("Error getting filtered "+kind.Plural+" facets: ", utils.Err(err))
+			utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+			return
+		}
+		responseData["facets"] = facets
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
+
+// HistoryHandler lists the audit entries of one event, newest first. It
+// also serves events that have since been deleted.
+func (h *EventHandler[T, PT]) HistoryHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	total, err := h.EventService.GetHistoryCount(eventID)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	history, err := h.EventService.GetHistory(eventID, page, pageSize)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"history":    history,
+		"pagination": pagination.New(page, pageSize, total),
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
+
+// RevisionsHandler lists the previous versions of one event, newest first.
+func (h *EventHandler[T, PT]) RevisionsHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	total, err := h.EventService.GetRevisionCount(eventID)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	revisions, err := h.EventService.GetRevisions(eventID, page, pageSize)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"revisions":  revisions,
+		"pagination": pagination.New(page, pageSize, total),
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
+
+// RevisionHandler serves one event as it was at the given revision.
+func (h *EventHandler[T, PT]) RevisionHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	number, ok := parseRevision(w, chi.URLParam(r, "rev"))
+	if !ok {
+		return
+	}
+
+	event, err := h.EventService.GetRevision(eventID, number)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	base := PT(event).Base()
+	respondWithVersionedJSON(w, r, event, base.Version, base.UpdatedAt)
+}
+
+// RevisionDiffHandler compares revision from with revision to, or with the
+// current version when to is omitted.
+func (h *EventHandler[T, PT]) RevisionDiffHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	from, ok := parseRevision(w, r.URL.Query().Get("from"))
+	if !ok {
+		return
+	}
+
+	to := 0
+	if value := r.URL.Query().Get("to"); value != "" {
+		if to, ok = parseRevision(w, value); !ok {
+			return
+		}
+	}
+
+	diff, err := h.EventService.DiffRevisions(eventID, from, to)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	respondWithListJSON(w, r, diff)
+}
+
+// RestoreRevisionHandler rolls an event back to the content of a revision.
+// If-Match is checked against the current version as for updates.
+func (h *EventHandler[T, PT]) RestoreRevisionHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	number, ok := parseRevision(w, chi.URLParam(r, "rev"))
+	if !ok {
+		return
+	}
+
+	version, ok := parseIfMatch(w, r)
+	if !ok {
+		return
+	}
+
+	event, err := h.EventService.RestoreRevision(r.Context(), eventID, number, version)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	setETag(w, PT(event).Base().Version)
+	utils.RespondWithJSON(w, status.OK, event)
+}
+
+// TrashHandler lists the deleted events that can still be restored, most
+// recently deleted first.
+func (h *EventHandler[T, PT]) TrashHandler(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	total, err := h.EventService.GetTrashCount()
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	events, err := h.EventService.GetTrash(page, pageSize)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		h.kind().Plural: events,
+		"pagination":    pagination.New(page, pageSize, total),
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
+
+// RestoreHandler takes an event out of the trash. Events that are not in
+// the trash, including purged ones, are not found.
+func (h *EventHandler[T, PT]) RestoreHandler(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := h.parseEventID(w, r)
+	if !ok {
+		return
+	}
+
+	event, err := h.EventService.Restore(r.Context(), eventID)
+	if err != nil {
+		h.respondWithEventError(w, err)
+		return
+	}
+
+	setETag(w, PT(event).Base().Version)
+	utils.RespondWithJSON(w, status.OK, event)
+}
+
+// SuggestHandler serves typeahead lookups, it is meant to be called on every
+// keystroke and returns only the fields a suggestion list shows.
+func (h *EventHandler[T, PT]) SuggestHandler(w http.ResponseWriter, r *http.Request) {
+	limit := defaultSuggestLimit
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > maxSuggestLimit {
+			utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidLimit)
+			return
+		}
+		limit = parsed
+	}
+
+	suggestions, err := h.EventService.Suggest(r.URL.Query().Get("q"), limit)
+	if err != nil {
+		slog.Error("Error getting "+string(h.kind().Type)+" suggestions: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"suggestions": suggestions,
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
+
+func (h *EventHandler[T, PT]) kind() domain.EventKind {
+	return PT(new(T)).Kind()
+}
+
+func (h *EventHandler[T, PT]) notFoundMessage() string {
+	return fmt.Sprintf(errs.EventKindNotFound, h.kind().Name)
+}
+
+func (h *EventHandler[T, PT]) parseEventID(w http.ResponseWriter, r *http.Request) (primitive.ObjectID, bool) {
+	return parseEventID(w, r, h.kind().Type)
+}
+
+func (h *EventHandler[T, PT]) respondWithEventError(w http.ResponseWriter, err error) {
+	if respondWithValidationError(w, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrForbidden):
+		utils.RespondWithErrorJSON(w, status.Forbidden, errs.Forbidden)
+	case errors.Is(err, domain.ErrEventNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, h.notFoundMessage())
+	case errors.Is(err, domain.ErrRevisionNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.RevisionNotFound)
+	case errors.Is(err, domain.ErrVersionMismatch):
+		utils.RespondWithErrorJSON(w, status.PreconditionFailed, errs.VersionMismatch)
+	case errors.Is(err, domain.ErrInvalidPatch):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidPatch)
+	case errors.Is(err, domain.ErrInvalidFilter):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidFilter)
+	case errors.Is(err, domain.ErrUnsupportedFilter):
+		utils.RespondWithErrorJSON(w, status.BadRequest, fmt.Sprintf(errs.UnsupportedFilter, strings.ToLower(h.kind().Name)))
+	default:
+		slog.Error("Error handling "+string(h.kind().Type)+" request: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+	}
+}
+
+// respondWithForbidden rejects anonymous callers as unauthenticated and
+// others as forbidden.
+func respondWithForbidden(w http.ResponseWriter, r *http.Request) {
+	if domain.PrincipalFrom(r.Context()) == nil {
+		utils.RespondWithErrorJSON(w, status.Unauthorized, errs.Unauthenticated)
+		return
+	}
+
+	utils.RespondWithErrorJSON(w, status.Forbidden, errs.Forbidden)
+}
+
+// wantsFacets reports whether the client asked for facet counts with
+// facets=true. Facets are returned with page-based results only.
+func wantsFacets(r *http.Request) bool {
+	want, _ := strconv.ParseBool(r.URL.Query().Get("facets"))
+	return want
+}
+
+func parseEventID(w http.ResponseWriter, r *http.Request, eventType domain.EventType) (primitive.ObjectID, bool) {
+	eventID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, fmt.Sprintf(errs.InvalidEventID, eventType))
+		return primitive.NilObjectID, false
+	}
+
+	return eventID, true
+}
+
+// parseRevision parses a revision number, revisions start at 1.
+func parseRevision(w http.ResponseWriter, value string) (int, bool) {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRevision)
+		return 0, false
+	}
+
+	return number, true
+}
+
+func eventCursorID[T any, PT domain.EventPtr[T]](item *T) primitive.ObjectID {
+	return PT(item).Base().ID
+}
@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"errors"
+	"events/internal/domain"
+	service "events/internal/service/interfaces"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/pagination"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type FeedHandler struct {
+	FeedService service.FeedService
+	Router      *chi.Mux
+}
+
+// GetFeedHandler lists events of every kind in one page. Filters are the
+// repeatable type, category and tags parameters, query, and an optional
+// from/to date range. The feed is newest first unless sort is given.
+func (h *FeedHandler) GetFeedHandler(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	sort, ok := parseSortParam(w, r, domain.FeedSortFields())
+	if !ok {
+		return
+	}
+
+	values := r.URL.Query()
+
+	filter := domain.FeedFilter{
+		Categories: values["category"],
+		Tags:       values["tags"],
+		Query:      values.Get("query"),
+	}
+	for _, eventType := range values["type"] {
+		filter.Types = append(filter.Types, domain.EventType(eventType))
+	}
+
+	var err error
+	if filter.From, err = parseOptionalTime(values.Get("from")); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidTimeRange)
+		return
+	}
+	if filter.To, err = parseOptionalTime(values.Get("to")); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidTimeRange)
+		return
+	}
+
+	items, total, err := h.FeedService.GetFeed(filter, sort, page, pageSize)
+	if err != nil {
+		respondWithFeedError(w, err)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"events":     items,
+		"pagination": pagination.New(page, pageSize, total),
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
+
+func respondWithFeedError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrInvalidEventType):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidEventType)
+	case errors.Is(err, domain.ErrInvalidTimeRange):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidTimeRange)
+	default:
+		slog.Error("Error getting event feed: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+	}
+}
+
+func parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return parseTime(value)
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"events/pkg/lib/errs"
+	"events/pkg/lib/pagination"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// parsePageParams reads the page and page_size query parameters, falling back
+// to the first page and the default page size. It responds with 400 and
+// returns false when either is invalid.
+func parsePageParams(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	page := 1
+	pageSize := pagination.DefaultPageSize
+
+	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
+		pageNum, err := strconv.Atoi(pageStr)
+		if err != nil || pageNum < 1 {
+			utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidPage)
+			return 0, 0, false
+		}
+		page = pageNum
+	}
+
+	if pageSizeStr := r.URL.Query().Get("page_size"); pageSizeStr != "" {
+		size, err := strconv.Atoi(pageSizeStr)
+		if err != nil || size < 1 || size > pagination.MaxPageSize {
+			utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidPageSize)
+			return 0, 0, false
+		}
+		pageSize = size
+	}
+
+	return page, pageSize, true
+}
+
+// isCursorRequest reports whether the client asked for cursor pagination.
+// An empty cursor parameter requests the first page.
+func isCursorRequest(r *http.Request) bool {
+	return r.URL.Query().Has("cursor")
+}
+
+// respondWithCursorPage serves one page in cursor mode. fetch is asked for one
+// item more than the page size so that next_cursor is only returned when
+// there really is a next page.
+func respondWithCursorPage[T any](w http.ResponseWriter, r *http.Request, key string, pageSize int, fetch func(after primitive.ObjectID, limit int) ([]*T, error), id func(*T) primitive.ObjectID) {
+	cursor, err := pagination.DecodeCursor(r.URL.Query().Get("cursor"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidCursor)
+		return
+	}
+
+	items, err := fetch(cursor.ID, pageSize+1)
+	if err != nil {
+		slog.Error("Error getting "+key+": ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	page := pagination.CursorPagination{PageSize: pageSize}
+	if len(items) > pageSize {
+		items = items[:pageSize]
+		nextCursor := pagination.EncodeCursor(pagination.Cursor{ID: id(items[len(items)-1])})
+		page.NextCursor = &nextCursor
+	}
+
+	responseData := map[string]interface{}{
+		key:          items,
+		"pagination": page,
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
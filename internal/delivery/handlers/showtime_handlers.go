@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"events/internal/domain"
+	service "events/internal/service/interfaces"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/pagination"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultShowtimeWindow      = 24 * time.Hour
+	defaultEventShowtimeWindow = 30 * 24 * time.Hour
+)
+
+type ShowtimeHandler struct {
+	ShowtimeService service.ShowtimeService
+	Router          *chi.Mux
+}
+
+func (h *ShowtimeHandler) GetShowtimesHandler(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	from, to, err := parseTimeRange(r, defaultShowtimeWindow)
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidTimeRange)
+		return
+	}
+
+	totalShowtimes, err := h.ShowtimeService.GetShowtimesInRangeCount(from, to)
+	if err != nil {
+		slog.Error("Error getting showtimes count: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	showtimes, err := h.ShowtimeService.GetShowtimesInRange(from, to, page, pageSize)
+	if err != nil {
+		slog.Error("Error getting showtimes: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"showtimes":  showtimes,
+		"from":       from,
+		"to":         to,
+		"pagination": pagination.New(page, pageSize, totalShowtimes),
+	}
+
+	utils.RespondWithJSON(w, status.OK, responseData)
+}
+
+// GetEventShowtimesHandler lists the showtimes of one event of the given type.
+func (h *ShowtimeHandler) GetEventShowtimesHandler(eventType domain.EventType) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.getEventShowtimes(w, r, eventType)
+	}
+}
+
+func (h *ShowtimeHandler) getEventShowtimes(w http.ResponseWriter, r *http.Request, eventType domain.EventType) {
+	eventID, ok := parseEventID(w, r, eventType)
+	if !ok {
+		return
+	}
+
+	from, to, err := parseTimeRange(r, defaultEventShowtimeWindow)
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidTimeRange)
+		return
+	}
+
+	showtimes, err := h.ShowtimeService.GetShowtimesByEvent(r.Context(), eventType, eventID, from, to)
+	if err != nil {
+		respondWithShowtimeError(w, err)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"showtimes": showtimes,
+		"from":      from,
+		"to":        to,
+	}
+
+	utils.RespondWithJSON(w, status.OK, responseData)
+}
+
+func (h *ShowtimeHandler) GetShowtimeByIDHandler(w http.ResponseWriter, r *http.Request) {
+	objectID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidShowtimeID)
+		return
+	}
+
+	showtime, err := h.ShowtimeService.GetShowtimeByID(objectID)
+	if err != nil {
+		slog.Error("Error getting showtime by ID: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	if showtime == nil {
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.ShowtimeNotFound)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, showtime)
+}
+
+func (h *ShowtimeHandler) CreateShowtimeHandler(w http.ResponseWriter, r *http.Request) {
+	var createShowtimeRequest domain.CreateShowtimeRequest
+	if err := json.NewDecoder(r.Body).Decode(&createShowtimeRequest); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	showtime, err := h.ShowtimeService.CreateShowtime(&createShowtimeRequest)
+	if err != nil {
+		respondWithShowtimeError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, showtime)
+}
+
+func (h *ShowtimeHandler) UpdateShowtimeHandler(w http.ResponseWriter, r *http.Request) {
+	objectID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidShowtimeID)
+		return
+	}
+
+	var updateShowtimeRequest domain.UpdateShowtimeRequest
+	if err := json.NewDecoder(r.Body).Decode(&updateShowtimeRequest); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	showtime, err := h.ShowtimeService.UpdateShowtime(objectID, &updateShowtimeRequest)
+	if err != nil {
+		respondWithShowtimeError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, showtime)
+}
+
+func (h *ShowtimeHandler) DeleteShowtimeHandler(w http.ResponseWriter, r *http.Request) {
+	objectID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidShowtimeID)
+		return
+	}
+
+	if err := h.ShowtimeService.DeleteShowtime(objectID); err != nil {
+		respondWithShowtimeError(w, err)
+		return
+	}
+
+	response := StatusMessage{
+		Code:    200,
+		Message: "Showtime deleted successfully",
+	}
+
+	utils.RespondWithJSON(w, status.OK, response)
+}
+
+func respondWithShowtimeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrShowtimeNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.ShowtimeNotFound)
+	case errors.Is(err, domain.ErrEventNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.EventNotFound)
+	case errors.Is(err, domain.ErrHallNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.HallNotFound)
+	case errors.Is(err, domain.ErrInvalidEventType):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidEventType)
+	case errors.Is(err, domain.ErrInvalidDuration):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.UnknownDuration)
+	case errors.Is(err, domain.ErrInvalidShowtime):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidShowtime)
+	case errors.Is(err, domain.ErrInvalidPriceTier):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidPriceTier)
+	case errors.Is(err, domain.ErrInvalidShowtimeStatus):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidStatus)
+	default:
+		slog.Error("Error handling showtime request: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+	}
+}
+
+// parseTimeRange reads the from/to query parameters. Both accept RFC 3339
+// timestamps or plain dates; from defaults to now and to defaults to from
+// plus the given window.
+func parseTimeRange(r *http.Request, window time.Duration) (time.Time, time.Time, error) {
+	from := time.Now()
+	if value := r.URL.Query().Get("from"); value != "" {
+		parsed, err := parseTime(value)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		from = parsed
+	}
+
+	to := from.Add(window)
+	if value := r.URL.Query().Get("to"); value != "" {
+		parsed, err := parseTime(value)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		to = parsed
+	}
+
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, errors.New("to must be after from")
+	}
+
+	return from, to, nil
+}
+
+func parseTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, value)
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"events/internal/domain"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"net/http"
+)
+
+// parseSortParam reads the sort query parameter against the whitelist in
+// fields. Cursor pages are keyed on _id, so a sort cannot be combined with a
+// cursor. It responds with 400 and returns false when the sort is rejected.
+func parseSortParam(w http.ResponseWriter, r *http.Request, fields map[string]string) (domain.Sort, bool) {
+	sort, err := domain.ParseSort(r.URL.Query().Get("sort"), fields)
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidSort)
+		return nil, false
+	}
+
+	if len(sort) > 0 && isCursorRequest(r) {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.SortWithCursor)
+		return nil, false
+	}
+
+	return sort, true
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"events/internal/domain"
+	service "events/internal/service/interfaces"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type UserHandler struct {
+	UserService service.UserService
+	Router      *chi.Mux
+}
+
+func (h *UserHandler) RegisterHandler(w http.ResponseWriter, r *http.Request) {
+	var request domain.RegisterRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	user, err := h.UserService.Register(&request)
+	if err != nil {
+		respondWithUserError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, user)
+}
+
+func (h *UserHandler) LoginHandler(w http.ResponseWriter, r *http.Request) {
+	var request domain.LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	tokens, err := h.UserService.Login(&request)
+	if err != nil {
+		respondWithUserError(w, err)
+		return
+	}
+
+	respondWithTokens(w, tokens)
+}
+
+// RefreshHandler trades a refresh token for a new token pair. The old
+// refresh token stops working.
+func (h *UserHandler) RefreshHandler(w http.ResponseWriter, r *http.Request) {
+	var request domain.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	tokens, err := h.UserService.Refresh(request.RefreshToken)
+	if err != nil {
+		respondWithUserError(w, err)
+		return
+	}
+
+	respondWithTokens(w, tokens)
+}
+
+func (h *UserHandler) LogoutHandler(w http.ResponseWriter, r *http.Request) {
+	var request domain.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	if err := h.UserService.Logout(request.RefreshToken); err != nil {
+		respondWithUserError(w, err)
+		return
+	}
+
+	response := StatusMessage{
+		Code:    200,
+		Message: "Logged out successfully",
+	}
+
+	utils.RespondWithJSON(w, status.OK, response)
+}
+
+// ForgotPasswordHandler answers the same whether or not the email belongs
+// to an account.
+func (h *UserHandler) ForgotPasswordHandler(w http.ResponseWriter, r *http.Request) {
+	var request domain.ForgotPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	if err := h.UserService.ForgotPassword(r.Context(), &request); err != nil {
+		respondWithUserError(w, err)
+		return
+	}
+
+	response := StatusMessage{
+		Code:    http.StatusAccepted,
+		Message: "If the email belongs to an account, a reset token is on its way",
+	}
+
+	utils.RespondWithJSON(w, http.StatusAccepted, response)
+}
+
+func (h *UserHandler) ResetPasswordHandler(w http.ResponseWriter, r *http.Request) {
+	var request domain.ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	if err := h.UserService.ResetPassword(&request); err != nil {
+		respondWithUserError(w, err)
+		return
+	}
+
+	response := StatusMessage{
+		Code:    200,
+		Message: "Password changed successfully",
+	}
+
+	utils.RespondWithJSON(w, status.OK, response)
+}
+
+// MeHandler returns the account of the user the request was made by.
+func (h *UserHandler) MeHandler(w http.ResponseWriter, r *http.Request) {
+	subject, ok := strings.CutPrefix(domain.ActorFrom(r.Context()), domain.UserSubjectPrefix)
+	if !ok {
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.UserNotFound)
+		return
+	}
+
+	userID, err := primitive.ObjectIDFromHex(subject)
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.UserNotFound)
+		return
+	}
+
+	user, err := h.UserService.GetUser(userID)
+	if err != nil {
+		respondWithUserError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, user)
+}
+
+// respondWithTokens keeps token responses out of every cache.
+func respondWithTokens(w http.ResponseWriter, tokens *domain.TokenPair) {
+	w.Header().Set("Cache-Control", "no-store")
+	utils.RespondWithJSON(w, status.OK, tokens)
+}
+
+func respondWithUserError(w http.ResponseWriter, err error) {
+	if respondWithValidationError(w, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, domain.ErrInvalidCredentials):
+		utils.RespondWithErrorJSON(w, status.Unauthorized, errs.InvalidCredentials)
+	case errors.Is(err, domain.ErrInvalidToken):
+		utils.RespondWithErrorJSON(w, status.Unauthorized, errs.InvalidToken)
+	case errors.Is(err, domain.ErrEmailTaken):
+		utils.RespondWithErrorJSON(w, status.Conflict, errs.EmailTaken)
+	case errors.Is(err, domain.ErrUserNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.UserNotFound)
+	default:
+		slog.Error("Error handling user request: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+	}
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"net/http"
+)
+
+// respondWithValidationError responds with 422 and the rejected fields when
+// err is a validation error, and reports whether it did.
+func respondWithValidationError(w http.ResponseWriter, err error) bool {
+	var validationErr *domain.ValidationError
+	if !errors.As(err, &validationErr) {
+		return false
+	}
+
+	fieldErrors := make([]utils.FieldError, 0, len(validationErr.Fields))
+	for _, field := range validationErr.Fields {
+		fieldErrors = append(fieldErrors, utils.FieldError{Field: field.Field, Message: field.Message})
+	}
+
+	utils.RespondWithErrorJSON(w, status.UnprocessableEntity, errs.ValidationFailed, fieldErrors...)
+	return true
+}
@@ -0,0 +1,289 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"events/internal/domain"
+	service "events/internal/service/interfaces"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/pagination"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type VenueHandler struct {
+	VenueService service.VenueService
+	Router       *chi.Mux
+}
+
+func (h *VenueHandler) GetAllVenuesHandler(w http.ResponseWriter, r *http.Request) {
+	page, pageSize, ok := parsePageParams(w, r)
+	if !ok {
+		return
+	}
+
+	sort, ok := parseSortParam(w, r, domain.VenueSortFields())
+	if !ok {
+		return
+	}
+
+	totalVenues, err := h.VenueService.GetTotalVenuesCount()
+	if err != nil {
+		slog.Error("Error getting total venues count: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	venues, err := h.VenueService.GetAllVenues(sort, page, pageSize)
+	if err != nil {
+		slog.Error("Error getting venues: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"venues":     venues,
+		"pagination": pagination.New(page, pageSize, totalVenues),
+	}
+
+	respondWithListJSON(w, r, responseData)
+}
+
+func (h *VenueHandler) GetVenueByIDHandler(w http.ResponseWriter, r *http.Request) {
+	venueID, ok := parseVenueID(w, r)
+	if !ok {
+		return
+	}
+
+	venue, err := h.VenueService.GetVenueByID(venueID)
+	if err != nil {
+		slog.Error("Error getting venue by ID: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+		return
+	}
+
+	if venue == nil {
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.VenueNotFound)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, venue)
+}
+
+func (h *VenueHandler) CreateVenueHandler(w http.ResponseWriter, r *http.Request) {
+	var createVenueRequest domain.CreateVenueRequest
+	if err := json.NewDecoder(r.Body).Decode(&createVenueRequest); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	venue, err := h.VenueService.CreateVenue(&createVenueRequest)
+	if err != nil {
+		respondWithVenueError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, venue)
+}
+
+func (h *VenueHandler) UpdateVenueHandler(w http.ResponseWriter, r *http.Request) {
+	venueID, ok := parseVenueID(w, r)
+	if !ok {
+		return
+	}
+
+	var updateVenueRequest domain.UpdateVenueRequest
+	if err := json.NewDecoder(r.Body).Decode(&updateVenueRequest); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	venue, err := h.VenueService.UpdateVenue(venueID, &updateVenueRequest)
+	if err != nil {
+		respondWithVenueError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, venue)
+}
+
+func (h *VenueHandler) DeleteVenueHandler(w http.ResponseWriter, r *http.Request) {
+	venueID, ok := parseVenueID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.VenueService.DeleteVenue(venueID); err != nil {
+		respondWithVenueError(w, err)
+		return
+	}
+
+	response := StatusMessage{
+		Code:    200,
+		Message: "Venue deleted successfully",
+	}
+
+	utils.RespondWithJSON(w, status.OK, response)
+}
+
+func (h *VenueHandler) GetHallsHandler(w http.ResponseWriter, r *http.Request) {
+	venueID, ok := parseVenueID(w, r)
+	if !ok {
+		return
+	}
+
+	halls, err := h.VenueService.GetHallsByVenue(venueID)
+	if err != nil {
+		respondWithVenueError(w, err)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"halls": halls,
+	}
+
+	utils.RespondWithJSON(w, status.OK, responseData)
+}
+
+func (h *VenueHandler) GetHallHandler(w http.ResponseWriter, r *http.Request) {
+	venueID, hallID, ok := parseHallID(w, r)
+	if !ok {
+		return
+	}
+
+	hall, err := h.VenueService.GetHall(venueID, hallID)
+	if err != nil {
+		respondWithVenueError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, hall)
+}
+
+func (h *VenueHandler) GetSeatMapHandler(w http.ResponseWriter, r *http.Request) {
+	venueID, hallID, ok := parseHallID(w, r)
+	if !ok {
+		return
+	}
+
+	hall, err := h.VenueService.GetHall(venueID, hallID)
+	if err != nil {
+		respondWithVenueError(w, err)
+		return
+	}
+
+	responseData := map[string]interface{}{
+		"capacity":       hall.Capacity,
+		"seatCategories": hall.SeatCategories,
+		"seatMap":        hall.SeatMap,
+	}
+
+	utils.RespondWithJSON(w, status.OK, responseData)
+}
+
+func (h *VenueHandler) CreateHallHandler(w http.ResponseWriter, r *http.Request) {
+	venueID, ok := parseVenueID(w, r)
+	if !ok {
+		return
+	}
+
+	var createHallRequest domain.CreateHallRequest
+	if err := json.NewDecoder(r.Body).Decode(&createHallRequest); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	hall, err := h.VenueService.CreateHall(venueID, &createHallRequest)
+	if err != nil {
+		respondWithVenueError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, http.StatusCreated, hall)
+}
+
+func (h *VenueHandler) UpdateHallHandler(w http.ResponseWriter, r *http.Request) {
+	venueID, hallID, ok := parseHallID(w, r)
+	if !ok {
+		return
+	}
+
+	var updateHallRequest domain.UpdateHallRequest
+	if err := json.NewDecoder(r.Body).Decode(&updateHallRequest); err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidRequestBody)
+		return
+	}
+
+	hall, err := h.VenueService.UpdateHall(venueID, hallID, &updateHallRequest)
+	if err != nil {
+		respondWithVenueError(w, err)
+		return
+	}
+
+	utils.RespondWithJSON(w, status.OK, hall)
+}
+
+func (h *VenueHandler) DeleteHallHandler(w http.ResponseWriter, r *http.Request) {
+	venueID, hallID, ok := parseHallID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.VenueService.DeleteHall(venueID, hallID); err != nil {
+		respondWithVenueError(w, err)
+		return
+	}
+
+	response := StatusMessage{
+		Code:    200,
+		Message: "Hall deleted successfully",
+	}
+
+	utils.RespondWithJSON(w, status.OK, response)
+}
+
+func parseVenueID(w http.ResponseWriter, r *http.Request) (primitive.ObjectID, bool) {
+	venueID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "id"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidVenueID)
+		return primitive.NilObjectID, false
+	}
+
+	return venueID, true
+}
+
+func parseHallID(w http.ResponseWriter, r *http.Request) (primitive.ObjectID, primitive.ObjectID, bool) {
+	venueID, ok := parseVenueID(w, r)
+	if !ok {
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+
+	hallID, err := primitive.ObjectIDFromHex(chi.URLParam(r, "hallId"))
+	if err != nil {
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidHallID)
+		return primitive.NilObjectID, primitive.NilObjectID, false
+	}
+
+	return venueID, hallID, true
+}
+
+func respondWithVenueError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrVenueNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.VenueNotFound)
+	case errors.Is(err, domain.ErrHallNotFound):
+		utils.RespondWithErrorJSON(w, status.NotFound, errs.HallNotFound)
+	case errors.Is(err, domain.ErrInvalidLocation):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidLocation)
+	case errors.Is(err, domain.ErrInvalidSeatMap):
+		utils.RespondWithErrorJSON(w, status.BadRequest, errs.InvalidSeatMap)
+	default:
+		slog.Error("Error handling venue request: ", utils.Err(err))
+		utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+	}
+}
@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/errs"
+	"events/pkg/lib/status"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"net/http"
+	"strings"
+)
+
+// APIKeyHeader carries the API key of services calling the API.
+const APIKeyHeader = "X-API-Key"
+
+// BookingTokenHeader carries the access token of a booking, issued when its
+// seats are held.
+const BookingTokenHeader = "X-Booking-Token"
+
+// Authenticator identifies callers by their credentials.
+type Authenticator interface {
+	AuthenticateToken(token string) (*domain.Principal, error)
+	AuthenticateAPIKey(key string) (*domain.Principal, error)
+}
+
+// Authenticate identifies the caller by a bearer token in the Authorization
+// header or by an API key in the X-API-Key header, and puts them into the
+// request context; changes the request makes are attributed to them.
+// Requests without credentials pass on anonymously, requests with invalid
+// ones are rejected.
+func Authenticate(authenticator Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var (
+				principal *domain.Principal
+				err       error
+			)
+
+			if authorization := r.Header.Get("Authorization"); authorization != "" {
+				scheme, token, _ := strings.Cut(authorization, " ")
+				if !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+					respondUnauthorized(w, errs.InvalidCredentials)
+					return
+				}
+				principal, err = authenticator.AuthenticateToken(strings.TrimSpace(token))
+			} else if key := r.Header.Get(APIKeyHeader); key != "" {
+				principal, err = authenticator.AuthenticateAPIKey(key)
+			} else {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if errors.Is(err, domain.ErrInvalidCredentials) {
+				respondUnauthorized(w, errs.InvalidCredentials)
+				return
+			}
+			if err != nil {
+				slog.Error("Error authenticating request: ", utils.Err(err))
+				utils.RespondWithErrorJSON(w, status.InternalServerError, errs.InternalServerError)
+				return
+			}
+
+			ctx := domain.WithPrincipal(r.Context(), principal)
+			ctx = domain.WithActor(ctx, principal.Subject)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// RequireCredentials rejects anonymous requests.
+func RequireCredentials(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if domain.PrincipalFrom(r.Context()) == nil {
+			respondUnauthorized(w, errs.Unauthenticated)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Authorize rejects requests whose caller doesn't hold permission for
+// eventType: anonymous ones as unauthenticated, others as forbidden. An
+// empty eventType asks for a catalog-wide permission.
+func Authorize(permission domain.Permission, eventType domain.EventType) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal := domain.PrincipalFrom(r.Context())
+			if principal == nil {
+				respondUnauthorized(w, errs.Unauthenticated)
+				return
+			}
+			if !principal.Can(permission, eventType) {
+				utils.RespondWithErrorJSON(w, status.Forbidden, errs.Forbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func respondUnauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="events"`)
+	utils.RespondWithErrorJSON(w, status.Unauthorized, message)
+}
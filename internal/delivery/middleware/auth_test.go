@@ -0,0 +1,115 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	"events/internal/delivery/middleware"
+	"events/internal/domain"
+)
+
+type fakeAuthenticator struct{}
+
+func (fakeAuthenticator) AuthenticateToken(token string) (*domain.Principal, error) {
+	switch token {
+	case "valid-token":
+		return &domain.Principal{Subject: "editor-1", Method: domain.AuthToken}, nil
+	case "movie-editor":
+		return &domain.Principal{
+			Subject: "movie-editor",
+			Method:  domain.AuthToken,
+			Roles:   []domain.RoleAssignment{{Role: domain.RoleEditor, EventTypes: []domain.EventType{domain.EventTypeMovie}}},
+		}, nil
+	}
+	return nil, domain.ErrInvalidCredentials
+}
+
+func (fakeAuthenticator) AuthenticateAPIKey(key string) (*domain.Principal, error) {
+	if key != "evk_valid" {
+		return nil, domain.ErrInvalidCredentials
+	}
+	return &domain.Principal{Subject: "apikey:1", Method: domain.AuthAPIKey}, nil
+}
+
+func TestAuthenticate(t *testing.T) {
+	whoami := func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(domain.ActorFrom(r.Context())))
+	}
+
+	router := chi.NewRouter()
+	router.Use(middleware.Authenticate(fakeAuthenticator{}))
+	router.Get("/", whoami)
+	router.With(middleware.RequireCredentials).Post("/", whoami)
+
+	tests := []struct {
+		name       string
+		method     string
+		headers    map[string]string
+		wantStatus int
+		wantActor  string
+	}{
+		{name: "Anonymous read", method: http.MethodGet, wantStatus: http.StatusOK, wantActor: domain.AnonymousActor},
+		{name: "Anonymous write", method: http.MethodPost, wantStatus: http.StatusUnauthorized},
+		{name: "Bearer token", method: http.MethodPost, headers: map[string]string{"Authorization": "Bearer valid-token"}, wantStatus: http.StatusOK, wantActor: "editor-1"},
+		{name: "API key", method: http.MethodPost, headers: map[string]string{middleware.APIKeyHeader: "evk_valid"}, wantStatus: http.StatusOK, wantActor: "apikey:1"},
+		{name: "Invalid token on a public route", method: http.MethodGet, headers: map[string]string{"Authorization": "Bearer expired"}, wantStatus: http.StatusUnauthorized},
+		{name: "Invalid API key", method: http.MethodPost, headers: map[string]string{middleware.APIKeyHeader: "evk_revoked"}, wantStatus: http.StatusUnauthorized},
+		{name: "Other scheme", method: http.MethodPost, headers: map[string]string{"Authorization": "Basic dXNlcjpwYXNz"}, wantStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(tt.method, "/", nil)
+			for key, value := range tt.headers {
+				request.Header.Set(key, value)
+			}
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+			if tt.wantStatus == http.StatusUnauthorized {
+				assert.NotEmpty(t, recorder.Header().Get("WWW-Authenticate"))
+				return
+			}
+			assert.Equal(t, tt.wantActor, recorder.Body.String())
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	router := chi.NewRouter()
+	router.Use(middleware.Authenticate(fakeAuthenticator{}))
+	router.With(middleware.Authorize(domain.PermissionWrite, domain.EventTypeMovie)).Post("/movie", func(w http.ResponseWriter, r *http.Request) {})
+	router.With(middleware.Authorize(domain.PermissionWrite, domain.EventTypePerformance)).Post("/performance", func(w http.ResponseWriter, r *http.Request) {})
+
+	tests := []struct {
+		name       string
+		path       string
+		token      string
+		wantStatus int
+	}{
+		{name: "Anonymous", path: "/movie", wantStatus: http.StatusUnauthorized},
+		{name: "No roles", path: "/movie", token: "valid-token", wantStatus: http.StatusForbidden},
+		{name: "Role for the type", path: "/movie", token: "movie-editor", wantStatus: http.StatusOK},
+		{name: "Role for another type", path: "/performance", token: "movie-editor", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(http.MethodPost, tt.path, nil)
+			if tt.token != "" {
+				request.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.wantStatus, recorder.Code)
+		})
+	}
+}
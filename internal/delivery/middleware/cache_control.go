@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// CacheControl sets the Cache-Control header of successful GET and HEAD
+// responses. routes maps chi route patterns such as "/api/movie/{id}" to a
+// policy; other routes get defaultPolicy. An empty policy sets no header,
+// and handlers that set their own header keep it. Responses to requests
+// with credentials may hold what anonymous callers can't see, so shared
+// caches must not keep them.
+func CacheControl(defaultPolicy string, routes map[string]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet && r.Method != http.MethodHead {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			writer := &cacheControlWriter{
+				ResponseWriter: w,
+				policy: func() string {
+					if hasCredentials(r) {
+						return privatePolicy
+					}
+					// The route pattern is only known once the router
+					// has matched the request.
+					if policy, ok := routes[chi.RouteContext(r.Context()).RoutePattern()]; ok {
+						return policy
+					}
+					return defaultPolicy
+				},
+			}
+			next.ServeHTTP(writer, r)
+
+			// An empty response is sent as 200 after the handler returns.
+			if !writer.wroteHeader {
+				writer.WriteHeader(http.StatusOK)
+			}
+		})
+	}
+}
+
+const privatePolicy = "private, no-cache"
+
+func hasCredentials(r *http.Request) bool {
+	return r.Header.Get("Authorization") != "" || r.Header.Get(APIKeyHeader) != "" || r.Header.Get(BookingTokenHeader) != ""
+}
+
+type cacheControlWriter struct {
+	http.ResponseWriter
+	policy      func() string
+	wroteHeader bool
+}
+
+func (w *cacheControlWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		if (code < http.StatusMultipleChoices || code == http.StatusNotModified) && w.Header().Get("Cache-Control") == "" {
+			if policy := w.policy(); policy != "" {
+				w.Header().Set("Cache-Control", policy)
+			}
+		}
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *cacheControlWriter) Write(data []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	return w.ResponseWriter.Write(data)
+}
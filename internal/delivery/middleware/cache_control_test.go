@@ -0,0 +1,63 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+
+	"events/internal/delivery/middleware"
+)
+
+func TestCacheControl(t *testing.T) {
+	movieRouter := chi.NewRouter()
+	movieRouter.Get("/", func(w http.ResponseWriter, r *http.Request) {})
+	movieRouter.Get("/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if chi.URLParam(r, "id") == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte("{}"))
+	})
+	movieRouter.Put("/{id}", func(w http.ResponseWriter, r *http.Request) {})
+
+	router := chi.NewRouter()
+	router.Use(middleware.CacheControl("no-cache", map[string]string{
+		"/api/movie/{id}": "public, max-age=300",
+	}))
+	router.Route("/api/movie", func(r chi.Router) {
+		r.Mount("/", movieRouter)
+	})
+
+	tests := []struct {
+		name    string
+		method  string
+		path    string
+		headers map[string]string
+		want    string
+	}{
+		{name: "Route policy", method: http.MethodGet, path: "/api/movie/1", want: "public, max-age=300"},
+		{name: "Default policy", method: http.MethodGet, path: "/api/movie", want: "no-cache"},
+		{name: "Errors are not cached", method: http.MethodGet, path: "/api/movie/missing", want: ""},
+		{name: "Writes are not cached", method: http.MethodPut, path: "/api/movie/1", want: ""},
+		{name: "Bearer token", method: http.MethodGet, path: "/api/movie/1", headers: map[string]string{"Authorization": "Bearer token"}, want: "private, no-cache"},
+		{name: "API key", method: http.MethodGet, path: "/api/movie", headers: map[string]string{middleware.APIKeyHeader: "evk_key"}, want: "private, no-cache"},
+		{name: "Booking token", method: http.MethodGet, path: "/api/movie", headers: map[string]string{middleware.BookingTokenHeader: "evb_token"}, want: "private, no-cache"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(tt.method, tt.path, nil)
+			for key, value := range tt.headers {
+				request.Header.Set(key, value)
+			}
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, request)
+
+			assert.Equal(t, tt.want, recorder.Header().Get("Cache-Control"))
+		})
+	}
+}
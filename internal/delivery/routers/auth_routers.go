@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"events/internal/delivery/handlers"
+	"events/internal/delivery/middleware"
+	"events/internal/domain"
+	"events/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// SetupAuthRouter registers the routes that show the caller and manage API
+// keys and role assignments. Managing them takes a catalog-wide admin.
+func SetupAuthRouter(authRouter *chi.Mux, authService *service.AuthService) {
+	authHandler := handlers.AuthHandler{
+		Router:      authRouter,
+		AuthService: authService,
+	}
+
+	authRouter.With(middleware.RequireCredentials).Get("/me", authHandler.MeHandler)
+
+	authRouter.Group(func(r chi.Router) {
+		r.Use(middleware.Authorize(domain.PermissionManage, ""))
+
+		r.Get("/keys", authHandler.GetAPIKeysHandler)
+		r.Post("/keys", authHandler.CreateAPIKeyHandler)
+		r.Delete("/keys/{id}", authHandler.RevokeAPIKeyHandler)
+		r.Get("/roles", authHandler.GetRoleAssignmentsHandler)
+		r.Post("/roles", authHandler.AssignRoleHandler)
+		r.Delete("/roles/{id}", authHandler.RevokeRoleHandler)
+	})
+}
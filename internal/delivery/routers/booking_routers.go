@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"events/internal/delivery/handlers"
+	"events/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func SetupBookingRouter(bookingRouter, showtimeRouter *chi.Mux, bookingService *service.BookingService) {
+	bookingHandler := handlers.BookingHandler{
+		Router:         bookingRouter,
+		BookingService: bookingService,
+	}
+
+	bookingRouter.Post("/", bookingHandler.HoldSeatsHandler)
+	bookingRouter.Get("/{id}", bookingHandler.GetBookingHandler)
+	bookingRouter.Post("/{id}/confirm", bookingHandler.ConfirmBookingHandler)
+	bookingRouter.Post("/{id}/cancel", bookingHandler.CancelBookingHandler)
+
+	showtimeRouter.Get("/{id}/seats", bookingHandler.GetSeatAvailabilityHandler)
+}
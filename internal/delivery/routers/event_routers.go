@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"events/internal/delivery/handlers"
+	"events/internal/delivery/middleware"
+	"events/internal/domain"
+	"events/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// SetupEventRouter registers the routes of one event kind. Reading published
+// events is open to anyone; the other routes require a role that allows
+// them for this kind.
+func SetupEventRouter[T any, PT domain.EventPtr[T]](eventRouter *chi.Mux, eventService *service.EventService[T, PT]) {
+	eventHandler := handlers.EventHandler[T, PT]{
+		Router:       eventRouter,
+		EventService: eventService,
+	}
+
+	eventType := PT(new(T)).Kind().Type
+	read := middleware.Authorize(domain.PermissionRead, eventType)
+	write := middleware.Authorize(domain.PermissionWrite, eventType)
+	remove := middleware.Authorize(domain.PermissionDelete, eventType)
+
+	eventRouter.Get("/", eventHandler.GetAllHandler)
+	eventRouter.Get("/{id}", eventHandler.GetByIDHandler)
+	eventRouter.Post("/query", eventHandler.QueryHandler)
+	eventRouter.Get("/search", eventHandler.SearchHandler)
+	eventRouter.Get("/suggest", eventHandler.SuggestHandler)
+	// Movies were filtered under /filter/tags and performances under /filter,
+	// both stay available for every kind.
+	eventRouter.Get("/filter", eventHandler.FilterByTagsHandler)
+	eventRouter.Get("/filter/tags", eventHandler.FilterByTagsHandler)
+
+	// Publishing is a write the service checks further, as it depends on
+	// the status sent.
+	eventRouter.With(write).Post("/", eventHandler.CreateHandler)
+	eventRouter.With(write).Put("/{id}", eventHandler.UpdateHandler)
+	eventRouter.With(write).Patch("/{id}", eventHandler.PatchHandler)
+	eventRouter.With(remove).Delete("/{id}", eventHandler.DeleteHandler)
+	eventRouter.With(remove).Post("/{id}/restore", eventHandler.RestoreHandler)
+	eventRouter.With(read).Get("/trash", eventHandler.TrashHandler)
+	eventRouter.With(read).Get("/{id}/history", eventHandler.HistoryHandler)
+	eventRouter.With(read).Get("/{id}/revisions", eventHandler.RevisionsHandler)
+	eventRouter.With(read).Get("/{id}/revisions/diff", eventHandler.RevisionDiffHandler)
+	eventRouter.With(read).Get("/{id}/revisions/{rev}", eventHandler.RevisionHandler)
+	eventRouter.With(write).Post("/{id}/revisions/{rev}/restore", eventHandler.RestoreRevisionHandler)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"events/internal/delivery/handlers"
+	"events/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func SetupFeedRouter(feedRouter *chi.Mux, feedService *service.FeedService) {
+	feedHandler := handlers.FeedHandler{
+		Router:      feedRouter,
+		FeedService: feedService,
+	}
+
+	feedRouter.Get("/", feedHandler.GetFeedHandler)
+}
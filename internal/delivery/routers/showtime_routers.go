@@ -0,0 +1,35 @@
+package routes
+
+import (
+	"events/internal/delivery/handlers"
+	"events/internal/delivery/middleware"
+	"events/internal/domain"
+	"events/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// SetupShowtimeRouter registers the showtime routes and a /{id}/showtimes
+// route on the router of every event kind.
+func SetupShowtimeRouter(showtimeRouter *chi.Mux, eventRouters map[domain.EventType]*chi.Mux, showtimeService *service.ShowtimeService) {
+	showtimeHandler := handlers.ShowtimeHandler{
+		Router:          showtimeRouter,
+		ShowtimeService: showtimeService,
+	}
+
+	showtimeRouter.Get("/", showtimeHandler.GetShowtimesHandler)
+	showtimeRouter.Get("/{id}", showtimeHandler.GetShowtimeByIDHandler)
+
+	// Showtimes can move between events of any type, so changing them needs
+	// a catalog-wide role.
+	write := middleware.Authorize(domain.PermissionWrite, "")
+	remove := middleware.Authorize(domain.PermissionDelete, "")
+
+	showtimeRouter.With(write).Post("/", showtimeHandler.CreateShowtimeHandler)
+	showtimeRouter.With(write).Put("/{id}", showtimeHandler.UpdateShowtimeHandler)
+	showtimeRouter.With(remove).Delete("/{id}", showtimeHandler.DeleteShowtimeHandler)
+
+	for eventType, eventRouter := range eventRouters {
+		eventRouter.Get("/{id}/showtimes", showtimeHandler.GetEventShowtimesHandler(eventType))
+	}
+}
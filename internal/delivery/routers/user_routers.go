@@ -0,0 +1,26 @@
+package routes
+
+import (
+	"events/internal/delivery/handlers"
+	"events/internal/delivery/middleware"
+	"events/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// SetupUserRouter registers the account routes. Only reading the account
+// needs an access token, the others work with what they are sent.
+func SetupUserRouter(userRouter *chi.Mux, userService *service.UserService) {
+	userHandler := handlers.UserHandler{
+		Router:      userRouter,
+		UserService: userService,
+	}
+
+	userRouter.Post("/register", userHandler.RegisterHandler)
+	userRouter.Post("/login", userHandler.LoginHandler)
+	userRouter.Post("/refresh", userHandler.RefreshHandler)
+	userRouter.Post("/logout", userHandler.LogoutHandler)
+	userRouter.Post("/password/forgot", userHandler.ForgotPasswordHandler)
+	userRouter.Post("/password/reset", userHandler.ResetPasswordHandler)
+	userRouter.With(middleware.RequireCredentials).Get("/me", userHandler.MeHandler)
+}
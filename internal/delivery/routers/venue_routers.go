@@ -0,0 +1,34 @@
+package routes
+
+import (
+	"events/internal/delivery/handlers"
+	"events/internal/delivery/middleware"
+	"events/internal/domain"
+	"events/internal/service"
+
+	"github.com/go-chi/chi/v5"
+)
+
+func SetupVenueRouter(venueRouter *chi.Mux, venueService *service.VenueService) {
+	venueHandler := handlers.VenueHandler{
+		Router:       venueRouter,
+		VenueService: venueService,
+	}
+
+	venueRouter.Get("/", venueHandler.GetAllVenuesHandler)
+	venueRouter.Get("/{id}", venueHandler.GetVenueByIDHandler)
+	venueRouter.Get("/{id}/halls", venueHandler.GetHallsHandler)
+	venueRouter.Get("/{id}/halls/{hallId}", venueHandler.GetHallHandler)
+	venueRouter.Get("/{id}/halls/{hallId}/seatmap", venueHandler.GetSeatMapHandler)
+
+	// Venues belong to no event type and need a catalog-wide role.
+	write := middleware.Authorize(domain.PermissionWrite, "")
+	remove := middleware.Authorize(domain.PermissionDelete, "")
+
+	venueRouter.With(write).Post("/", venueHandler.CreateVenueHandler)
+	venueRouter.With(write).Put("/{id}", venueHandler.UpdateVenueHandler)
+	venueRouter.With(remove).Delete("/{id}", venueHandler.DeleteVenueHandler)
+	venueRouter.With(write).Post("/{id}/halls", venueHandler.CreateHallHandler)
+	venueRouter.With(write).Put("/{id}/halls/{hallId}", venueHandler.UpdateHallHandler)
+	venueRouter.With(remove).Delete("/{id}/halls/{hallId}", venueHandler.DeleteHallHandler)
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "context"
+
+// AnonymousActor is recorded for changes made without a known user, and
+// SystemActor for changes made by background jobs.
+const (
+	AnonymousActor = "anonymous"
+	SystemActor    = "system"
+)
+
+type actorKey struct{}
+
+// WithActor returns a context that attributes changes to actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns the user changes made with ctx are attributed to.
+func ActorFrom(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+
+	return AnonymousActor
+}
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidAgeRating = errors.New("invalid age rating")
+
+// maxAgeRating is the highest minimum age a rating can require.
+const maxAgeRating = 21
+
+var ageNumberRegex = regexp.MustCompile(`\d+`)
+
+// ageRatingNames maps letter ratings without a number to a minimum age.
+var ageRatingNames = map[string]AgeRating{
+	"g":        0,
+	"u":        0,
+	"pg":       0,
+	"all":      0,
+	"all ages": 0,
+	"r":        17,
+}
+
+// AgeRating is the minimum age of the audience an event is suitable for.
+// Events without a rating leave the field nil.
+type AgeRating int
+
+// ParseAgeRating reads the legacy free-form ratings stored on events, e.g.
+// "18+", "12 yaş", "PG-13" or "G".
+func ParseAgeRating(value string) (AgeRating, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if rating, ok := ageRatingNames[value]; ok {
+		return rating, nil
+	}
+
+	number := ageNumberRegex.FindString(value)
+	if number == "" {
+		return 0, ErrInvalidAgeRating
+	}
+
+	age, err := strconv.Atoi(number)
+	if err != nil || age > maxAgeRating {
+		return 0, ErrInvalidAgeRating
+	}
+
+	return AgeRating(age), nil
+}
+
+// UnmarshalJSON accepts a minimum age as well as the legacy string forms.
+func (a *AgeRating) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch value := value.(type) {
+	case float64:
+		if value < 0 || value > maxAgeRating || value != math.Trunc(value) {
+			return ErrInvalidAgeRating
+		}
+		*a = AgeRating(value)
+	case string:
+		rating, err := ParseAgeRating(value)
+		if err != nil {
+			return err
+		}
+		*a = rating
+	default:
+		return ErrInvalidAgeRating
+	}
+
+	return nil
+}
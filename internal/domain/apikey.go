@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// APIKey is a static credential for services calling the API. Only a hash
+// of the key is stored; Prefix is kept so that keys can be told apart.
+type APIKey struct {
+	ID        primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Name      string             `json:"name" bson:"name"`
+	Prefix    string             `json:"prefix" bson:"prefix"`
+	Hash      string             `json:"-" bson:"hash"`
+	CreatedAt time.Time          `json:"createdAt" bson:"createdAt"`
+	CreatedBy string             `json:"createdBy" bson:"createdBy"`
+	RevokedAt *time.Time         `json:"revokedAt,omitempty" bson:"revokedAt,omitempty"`
+}
+
+type CreateAPIKeyRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateAPIKeyResponse carries the key itself, which is only ever shown
+// once.
+type CreateAPIKeyResponse struct {
+	APIKey
+	Key string `json:"key"`
+}
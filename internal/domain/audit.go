@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type AuditAction string
+
+const (
+	AuditCreate  AuditAction = "create"
+	AuditUpdate  AuditAction = "update"
+	AuditDelete  AuditAction = "delete"
+	AuditRestore AuditAction = "restore"
+	AuditPurge   AuditAction = "purge"
+)
+
+// FieldChange holds the values of one top-level field before and after a
+// change, in their JSON form. A missing side means the field was unset.
+type FieldChange struct {
+	Field  string      `json:"field" bson:"field"`
+	Before interface{} `json:"before,omitempty" bson:"before,omitempty"`
+	After  interface{} `json:"after,omitempty" bson:"after,omitempty"`
+}
+
+// AuditEntry records who changed an event, when, and how.
+type AuditEntry struct {
+	ID         primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	EntityType EventType          `json:"entityType" bson:"entityType"`
+	EntityID   primitive.ObjectID `json:"entityId" bson:"entityId"`
+	Action     AuditAction        `json:"action" bson:"action"`
+	Actor      string             `json:"actor" bson:"actor"`
+	At         time.Time          `json:"at" bson:"at"`
+	Changes    []FieldChange      `json:"changes" bson:"changes"`
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type BookingStatus string
+
+const (
+	BookingHeld      BookingStatus = "held"
+	BookingConfirmed BookingStatus = "confirmed"
+	BookingCancelled BookingStatus = "cancelled"
+	BookingExpired   BookingStatus = "expired"
+)
+
+type Customer struct {
+	Name  string `json:"name" bson:"name"`
+	Email string `json:"email" bson:"email"`
+	Phone string `json:"phone" bson:"phone"`
+}
+
+type BookingSeat struct {
+	Seat     string  `json:"seat" bson:"seat"`
+	Category string  `json:"category" bson:"category"`
+	Price    float64 `json:"price" bson:"price"`
+	Currency string  `json:"currency" bson:"currency"`
+}
+
+// SeatLock marks a seat of a showtime as taken. Locks of unconfirmed holds
+// carry an expiry time after which the seat is free again.
+type SeatLock struct {
+	ShowtimeID primitive.ObjectID `bson:"showtimeId"`
+	Seat       string             `bson:"seat"`
+	BookingID  primitive.ObjectID `bson:"bookingId"`
+	ExpiresAt  *time.Time         `bson:"expiresAt,omitempty"`
+}
+
+type CreateBookingRequest struct {
+	ShowtimeID primitive.ObjectID `json:"showtimeId" bson:"showtimeId"`
+	Seats      []string           `json:"seats" bson:"seats"`
+	Customer   Customer           `json:"customer" bson:"customer"`
+}
+
+type CommonBookingResponse struct {
+	ID          primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	ShowtimeID  primitive.ObjectID `json:"showtimeId" bson:"showtimeId"`
+	Seats       []BookingSeat      `json:"seats" bson:"seats"`
+	Customer    Customer           `json:"customer" bson:"customer"`
+	Status      BookingStatus      `json:"status" bson:"status"`
+	Total       float64            `json:"total" bson:"total"`
+	TicketCode  string             `json:"ticketCode,omitempty" bson:"ticketCode,omitempty"`
+	CreatedAt   time.Time          `json:"createdAt" bson:"createdAt"`
+	ExpiresAt   *time.Time         `json:"expiresAt,omitempty" bson:"expiresAt,omitempty"`
+	ConfirmedAt *time.Time         `json:"confirmedAt,omitempty" bson:"confirmedAt,omitempty"`
+	CancelledAt *time.Time         `json:"cancelledAt,omitempty" bson:"cancelledAt,omitempty"`
+	// AccessToken lets the customer see and change the booking without an
+	// account. It is only returned once, when the seats are held; the
+	// booking keeps its hash.
+	AccessToken     string `json:"accessToken,omitempty" bson:"-"`
+	AccessTokenHash string `json:"-" bson:"accessTokenHash"`
+}
+
+type GetBookingResponse CommonBookingResponse
+type CreateBookingResponse CommonBookingResponse
+
+type SeatAvailability struct {
+	ShowtimeID     primitive.ObjectID `json:"showtimeId"`
+	HallID         primitive.ObjectID `json:"hallId"`
+	Capacity       int                `json:"capacity"`
+	SeatCategories []SeatCategory     `json:"seatCategories"`
+	PriceTiers     []PriceTier        `json:"priceTiers"`
+	SeatMap        SeatMap            `json:"seatMap"`
+	TakenSeats     []string           `json:"takenSeats"`
+}
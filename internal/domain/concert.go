@@ -0,0 +1,30 @@
+package domain
+
+type Performer struct {
+	Name  string `json:"name" bson:"name"`
+	Role  string `json:"role" bson:"role"` // e.g. "headliner", "support"
+	Image string `json:"image" bson:"image"`
+}
+
+type Concert struct {
+	EventBase `bson:",inline"`
+	Lineup    []Performer `json:"lineup" bson:"lineup"`
+}
+
+func (*Concert) Kind() EventKind {
+	return EventKind{
+		Type:         EventTypeConcert,
+		Name:         "Concert",
+		Plural:       "concerts",
+		SearchFields: []string{"name", "lineup.name"},
+		MatchAllTags: true,
+	}
+}
+
+func (c *Concert) AlternateNames() []string {
+	names := make([]string, 0, len(c.Lineup))
+	for _, performer := range c.Lineup {
+		names = append(names, performer.Name)
+	}
+	return names
+}
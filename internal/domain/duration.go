@@ -0,0 +1,126 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+var ErrInvalidDuration = errors.New("invalid duration")
+
+var durationPartRegex = regexp.MustCompile(`(\d+(?:[.,]\d+)?)\s*([a-zа-я]*)`)
+
+// ParseDuration understands the free-form duration strings stored on events,
+// e.g. "120", "120 mins", "2h 10m", "1.5 hours" or "1 ч 40 мин". Decimals may
+// use a comma.
+func ParseDuration(value string) (time.Duration, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return 0, ErrInvalidDuration
+	}
+
+	parts := durationPartRegex.FindAllStringSubmatch(value, -1)
+	if len(parts) == 0 {
+		return 0, ErrInvalidDuration
+	}
+
+	var total time.Duration
+	for _, part := range parts {
+		amount, err := strconv.ParseFloat(strings.Replace(part[1], ",", ".", 1), 64)
+		if err != nil {
+			return 0, ErrInvalidDuration
+		}
+
+		var unit time.Duration
+		switch {
+		case part[2] == "", strings.HasPrefix(part[2], "m"), strings.HasPrefix(part[2], "мин"):
+			unit = time.Minute
+		case strings.HasPrefix(part[2], "h"), strings.HasPrefix(part[2], "ч"):
+			unit = time.Hour
+		default:
+			return 0, ErrInvalidDuration
+		}
+
+		total += time.Duration(math.Round(amount * float64(unit)))
+	}
+
+	if total <= 0 {
+		return 0, ErrInvalidDuration
+	}
+
+	return total, nil
+}
+
+// Minutes is the running time of an event. Zero means the duration is not
+// known and is stored and returned as null.
+type Minutes int
+
+// ParseMinutes reads a duration in any form ParseDuration understands. Like
+// numbers, strings must come to a whole number of minutes.
+func ParseMinutes(value string) (Minutes, error) {
+	duration, err := ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if duration%time.Minute != 0 {
+		return 0, ErrInvalidDuration
+	}
+
+	return Minutes(duration / time.Minute), nil
+}
+
+func (m Minutes) Duration() time.Duration {
+	return time.Duration(m) * time.Minute
+}
+
+func (m Minutes) MarshalJSON() ([]byte, error) {
+	if m == 0 {
+		return []byte("null"), nil
+	}
+
+	return []byte(strconv.Itoa(int(m))), nil
+}
+
+// UnmarshalJSON accepts a number of minutes as well as the legacy string
+// forms, e.g. "120 mins".
+func (m *Minutes) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch value := value.(type) {
+	case nil:
+		*m = 0
+	case float64:
+		if value < 0 || value != math.Trunc(value) {
+			return ErrInvalidDuration
+		}
+		*m = Minutes(value)
+	case string:
+		minutes, err := ParseMinutes(value)
+		if err != nil {
+			return err
+		}
+		*m = minutes
+	default:
+		return ErrInvalidDuration
+	}
+
+	return nil
+}
+
+func (m Minutes) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if m == 0 {
+		return bsontype.Null, nil, nil
+	}
+
+	return bson.MarshalValue(int32(m))
+}
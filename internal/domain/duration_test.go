@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventBaseLegacyJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		wantDuration Minutes
+		wantAge      *int
+		wantErr      bool
+	}{
+		{name: "Typed values", body: `{"duration": 95, "age": 12}`, wantDuration: 95, wantAge: intPtr(12)},
+		{name: "Legacy strings", body: `{"duration": "2h 10m", "age": "18+"}`, wantDuration: 130, wantAge: intPtr(18)},
+		{name: "Letter rating", body: `{"age": "PG-13"}`, wantAge: intPtr(13)},
+		{name: "All audiences", body: `{"age": "G"}`, wantAge: intPtr(0)},
+		{name: "Unknown values", body: `{"duration": null, "age": null}`},
+		{name: "Unreadable duration", body: `{"duration": "soon"}`, wantErr: true},
+		{name: "Fractional duration", body: `{"duration": 90.5}`, wantErr: true},
+		{name: "Unreadable age", body: `{"age": "adults"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var event EventBase
+			err := json.Unmarshal([]byte(tt.body), &event)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantDuration, event.Duration)
+			if tt.wantAge == nil {
+				assert.Nil(t, event.Age)
+			} else if assert.NotNil(t, event.Age) {
+				assert.Equal(t, *tt.wantAge, int(*event.Age))
+			}
+		})
+	}
+}
+
+func TestParseMinutes(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Minutes
+		wantErr bool
+	}{
+		{value: "120", want: 120},
+		{value: "120 mins", want: 120},
+		{value: "2h 10m", want: 130},
+		{value: "1 ч 40 мин", want: 100},
+		{value: "1.5 hours", want: 90},
+		{value: "1,5 ч", want: 90},
+		{value: "2.25h", want: 135},
+		{value: "90.5 min", wantErr: true},
+		{value: "soon", wantErr: true},
+		{value: "2 days", wantErr: true},
+		{value: "0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseMinutes(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidDuration)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMinutesUnknownIsNull(t *testing.T) {
+	data, err := json.Marshal(EventBase{})
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"duration":null`)
+	assert.Contains(t, string(data), `"age":null`)
+}
+
+func intPtr(i int) *int {
+	return &i
+}
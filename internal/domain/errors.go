@@ -0,0 +1,40 @@
+package domain
+
+import "errors"
+
+var (
+	ErrEventNotFound         = errors.New("event not found")
+	ErrInvalidEventType      = errors.New("invalid event type")
+	ErrInvalidTimeRange      = errors.New("invalid time range")
+	ErrInvalidFilter         = errors.New("invalid filter")
+	ErrUnsupportedFilter     = errors.New("filter is not supported by this event type")
+	ErrInvalidSort           = errors.New("invalid sort")
+	ErrValidation            = errors.New("validation failed")
+	ErrInvalidPatch          = errors.New("invalid patch")
+	ErrVersionMismatch       = errors.New("event was modified by someone else")
+	ErrRevisionNotFound      = errors.New("revision not found")
+	ErrShowtimeNotFound      = errors.New("showtime not found")
+	ErrInvalidShowtime       = errors.New("showtime must end after it starts")
+	ErrInvalidPriceTier      = errors.New("invalid price tier")
+	ErrInvalidShowtimeStatus = errors.New("invalid showtime status")
+	ErrVenueNotFound         = errors.New("venue not found")
+	ErrInvalidLocation       = errors.New("invalid venue location")
+	ErrHallNotFound          = errors.New("hall not found")
+	ErrInvalidSeatMap        = errors.New("invalid seat map")
+	ErrBookingNotFound       = errors.New("booking not found")
+	ErrInvalidSeats          = errors.New("invalid seat selection")
+	ErrSeatsUnavailable      = errors.New("seats are already taken")
+	ErrShowtimeNotBookable   = errors.New("showtime is not open for booking")
+	ErrHoldExpired           = errors.New("seat hold has expired")
+	ErrBookingNotHeld        = errors.New("booking is not an active hold")
+	ErrBookingNotCancellable = errors.New("booking can no longer be cancelled")
+	ErrInvalidCredentials    = errors.New("invalid credentials")
+	ErrAPIKeyNotFound        = errors.New("api key not found")
+	ErrForbidden             = errors.New("not allowed")
+	ErrInvalidRole           = errors.New("invalid role assignment")
+	ErrRoleNotFound          = errors.New("role assignment not found")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrEmailTaken            = errors.New("email is already registered")
+	ErrTokenNotFound         = errors.New("token not found")
+	ErrInvalidToken          = errors.New("invalid or expired token")
+)
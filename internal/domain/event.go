@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type EventType string
+
+const (
+	EventTypeMovie       EventType = "movie"
+	EventTypePerformance EventType = "performance"
+	EventTypeConcert     EventType = "concert"
+	EventTypeExhibition  EventType = "exhibition"
+	EventTypeSport       EventType = "sport"
+)
+
+func (t EventType) IsValid() bool {
+	switch t {
+	case EventTypeMovie, EventTypePerformance, EventTypeConcert, EventTypeExhibition, EventTypeSport:
+		return true
+	}
+	return false
+}
+
+// EventKind describes an event kind to the generic repository, service and
+// handler layers.
+type EventKind struct {
+	Type         EventType
+	Name         string   // Display name used in messages, e.g. "Movie"
+	Plural       string   // Key of the list in responses, e.g. "movies"
+	SearchFields []string // Name-like fields, ranked first by search
+	MatchAllTags bool     // Whether the tag filter requires all tags or any
+
+	// Dated kinds name the fields the feed date range is matched against,
+	// on top of their showtimes. Both may name the same field.
+	StartDateField string
+	EndDateField   string
+}
+
+// Event is implemented by pointers to every event kind. New kinds embed
+// EventBase and only have to declare their own fields and Kind.
+type Event interface {
+	Base() *EventBase
+	Kind() EventKind
+}
+
+// EventPtr lets generic code that holds a T reach the Event methods of *T.
+type EventPtr[T any] interface {
+	*T
+	Event
+}
+
+type EventBase struct {
+	ID          primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	Cover       string             `json:"cover" bson:"cover"`
+	Name        string             `json:"name" bson:"name"`
+	Description string             `json:"description" bson:"description"`
+	Duration    Minutes            `json:"duration" bson:"duration"`
+	Age         *AgeRating         `json:"age" bson:"age"`
+	Categories  []string           `json:"categories" bson:"categories"`
+	Tags        []string           `json:"tags" bson:"tags"`
+	Media       []string           `json:"media" bson:"media"`
+	Popularity  int                `json:"popularity" bson:"popularity"`
+	Status      PublicationStatus  `json:"status" bson:"status"`
+	PublishAt   *time.Time         `json:"publishAt" bson:"publishAt"`
+	UnpublishAt *time.Time         `json:"unpublishAt" bson:"unpublishAt"`
+	Version     int                `json:"version" bson:"version"` // Incremented on every edit
+	CreatedAt   time.Time          `json:"createdAt" bson:"createdAt"`
+	UpdatedAt   time.Time          `json:"updatedAt" bson:"updatedAt"`
+	CreatedBy   string             `json:"createdBy" bson:"createdBy"`
+	UpdatedBy   string             `json:"updatedBy" bson:"updatedBy"`
+	DeletedAt   *time.Time         `json:"deletedAt,omitempty" bson:"deletedAt,omitempty"` // Set while in the trash
+	DeletedBy   string             `json:"deletedBy,omitempty" bson:"deletedBy,omitempty"`
+
+	// Folded names for suggestions, maintained by the repository.
+	SearchName string   `json:"-" bson:"searchName,omitempty"`
+	SearchKeys []string `json:"-" bson:"searchKeys,omitempty"`
+}
+
+func (e *EventBase) Base() *EventBase {
+	return e
+}
+
+// AlternateNamer is implemented by kinds that are also looked up by names
+// other than their own, such as a movie's original title.
+type AlternateNamer interface {
+	AlternateNames() []string
+}
+
+// Suggestion is the short form of an event returned while the user types.
+type Suggestion struct {
+	ID           primitive.ObjectID `json:"id" bson:"_id"`
+	Name         string             `json:"name" bson:"name"`
+	OriginalName string             `json:"originalName,omitempty" bson:"originalName,omitempty"`
+	Cover        string             `json:"cover" bson:"cover"`
+}
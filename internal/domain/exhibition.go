@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// OpeningHours holds the hours of one weekday as "HH:MM" strings, a day
+// missing from the list is a closed day.
+type OpeningHours struct {
+	Day    time.Weekday `json:"day" bson:"day"`
+	Opens  string       `json:"opens" bson:"opens"`
+	Closes string       `json:"closes" bson:"closes"`
+}
+
+type Exhibition struct {
+	EventBase    `bson:",inline"`
+	OpenFrom     time.Time      `json:"openFrom" bson:"openFrom"`
+	OpenUntil    time.Time      `json:"openUntil" bson:"openUntil"`
+	OpeningHours []OpeningHours `json:"openingHours" bson:"openingHours"`
+}
+
+func (*Exhibition) Kind() EventKind {
+	return EventKind{
+		Type:           EventTypeExhibition,
+		Name:           "Exhibition",
+		Plural:         "exhibitions",
+		SearchFields:   []string{"name"},
+		MatchAllTags:   true,
+		StartDateField: "openFrom",
+		EndDateField:   "openUntil",
+	}
+}
@@ -0,0 +1,25 @@
+package domain
+
+type FacetCount struct {
+	Value string `json:"value" bson:"_id"`
+	Count int    `json:"count" bson:"count"`
+}
+
+type AgeCount struct {
+	Age   int `json:"age" bson:"_id"`
+	Count int `json:"count" bson:"count"`
+}
+
+type YearCount struct {
+	Year  int `json:"year" bson:"_id"`
+	Count int `json:"count" bson:"count"`
+}
+
+// Facets counts the events of a result set per value of the fields clients
+// filter on. Years are only counted for dated kinds.
+type Facets struct {
+	Categories []FacetCount `json:"categories" bson:"categories"`
+	Tags       []FacetCount `json:"tags" bson:"tags"`
+	Ages       []AgeCount   `json:"ages" bson:"ages"`
+	Years      []YearCount  `json:"years,omitempty" bson:"years"`
+}
@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// FeedFilter narrows the cross-kind event feed. Empty fields do not filter,
+// a zero From or To leaves that side of the date range open.
+type FeedFilter struct {
+	Types      []EventType
+	Categories []string
+	Tags       []string
+	Query      string
+	From       time.Time
+	To         time.Time
+}
+
+func (f FeedFilter) HasDateRange() bool {
+	return !f.From.IsZero() || !f.To.IsZero()
+}
+
+// FeedItem is an event of any kind. It is encoded as the event itself with
+// an extra "type" field telling clients which kind it is.
+type FeedItem struct {
+	Type  EventType
+	Event Event
+}
+
+func (i FeedItem) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(i.Event)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	fields["type"], err = json.Marshal(i.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(fields)
+}
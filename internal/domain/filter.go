@@ -0,0 +1,60 @@
+package domain
+
+import "time"
+
+// maxFilterValues bounds each value list of a filter.
+const maxFilterValues = 50
+
+// EventFilter is the filter language of the list endpoints. The repository
+// translates each field into a fixed query, so clients only ever supply
+// values, never operators or field names.
+type EventFilter struct {
+	TagsAll        []string   `json:"tagsAll,omitempty"`
+	TagsAny        []string   `json:"tagsAny,omitempty"`
+	TagsNone       []string   `json:"tagsNone,omitempty"`
+	CategoriesAll  []string   `json:"categoriesAll,omitempty"`
+	CategoriesAny  []string   `json:"categoriesAny,omitempty"`
+	CategoriesNone []string   `json:"categoriesNone,omitempty"`
+	ReleaseFrom    *time.Time `json:"releaseFrom,omitempty"` // Inclusive, matched against the kind's own dates
+	ReleaseTo      *time.Time `json:"releaseTo,omitempty"`   // Exclusive
+	DurationMin    *int       `json:"durationMin,omitempty"` // Minutes, inclusive
+	DurationMax    *int       `json:"durationMax,omitempty"`
+	AgeMin         *int       `json:"ageMin,omitempty"` // Minimum age ratings, inclusive
+	AgeMax         *int       `json:"ageMax,omitempty"` // e.g. 12 for events suitable for 12-year-olds
+	HasMedia       *bool      `json:"hasMedia,omitempty"`
+
+	// Statuses lists the publication statuses to include, only published
+	// events are included when it is empty.
+	Statuses []PublicationStatus `json:"statuses,omitempty"`
+}
+
+func (f EventFilter) Validate() error {
+	for _, values := range [][]string{f.TagsAll, f.TagsAny, f.TagsNone, f.CategoriesAll, f.CategoriesAny, f.CategoriesNone} {
+		if len(values) > maxFilterValues {
+			return ErrInvalidFilter
+		}
+	}
+
+	for _, status := range f.Statuses {
+		if !status.IsValid() {
+			return ErrInvalidFilter
+		}
+	}
+
+	if f.ReleaseFrom != nil && f.ReleaseTo != nil && !f.ReleaseTo.After(*f.ReleaseFrom) {
+		return ErrInvalidFilter
+	}
+
+	for _, bounds := range [][2]*int{{f.DurationMin, f.DurationMax}, {f.AgeMin, f.AgeMax}} {
+		min, max := bounds[0], bounds[1]
+		if (min != nil && *min < 0) || (max != nil && *max < 0) || (min != nil && max != nil && *max < *min) {
+			return ErrInvalidFilter
+		}
+	}
+
+	return nil
+}
+
+func (f EventFilter) HasReleaseRange() bool {
+	return f.ReleaseFrom != nil || f.ReleaseTo != nil
+}
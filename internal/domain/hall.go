@@ -0,0 +1,73 @@
+package domain
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+type SeatCategory struct {
+	Code  string `json:"code" bson:"code"`
+	Name  string `json:"name" bson:"name"`
+	Color string `json:"color" bson:"color"`
+}
+
+// Seat is a single place in a row. X and Y position the seat on the rendered
+// seat map so that aisles and curved rows can be drawn by the clients.
+type Seat struct {
+	Number     string `json:"number" bson:"number"`
+	Category   string `json:"category" bson:"category"`
+	X          int    `json:"x" bson:"x"`
+	Y          int    `json:"y" bson:"y"`
+	Accessible bool   `json:"accessible" bson:"accessible"`
+}
+
+type SeatRow struct {
+	Label string `json:"label" bson:"label"`
+	Seats []Seat `json:"seats" bson:"seats"`
+}
+
+type SeatMap struct {
+	Rows []SeatRow `json:"rows" bson:"rows"`
+}
+
+// SeatKey identifies a seat within a hall, e.g. "A-12".
+func SeatKey(row, number string) string {
+	return row + "-" + number
+}
+
+// Seats indexes the seat map by seat key.
+func (m SeatMap) Seats() map[string]Seat {
+	seats := make(map[string]Seat)
+	for _, row := range m.Rows {
+		for _, seat := range row.Seats {
+			seats[SeatKey(row.Label, seat.Number)] = seat
+		}
+	}
+	return seats
+}
+
+func (m SeatMap) Capacity() int {
+	capacity := 0
+	for _, row := range m.Rows {
+		capacity += len(row.Seats)
+	}
+	return capacity
+}
+
+type CommonHallRequest struct {
+	Name           string         `json:"name" bson:"name"`
+	SeatCategories []SeatCategory `json:"seatCategories" bson:"seatCategories"`
+	SeatMap        SeatMap        `json:"seatMap" bson:"seatMap"`
+}
+
+type CommonHallResponse struct {
+	ID             primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	VenueID        primitive.ObjectID `json:"venueId" bson:"venueId"`
+	Name           string             `json:"name" bson:"name"`
+	Capacity       int                `json:"capacity" bson:"capacity"`
+	SeatCategories []SeatCategory     `json:"seatCategories" bson:"seatCategories"`
+	SeatMap        SeatMap            `json:"seatMap" bson:"seatMap"`
+}
+
+type GetHallResponse CommonHallResponse
+type CreateHallRequest CommonHallRequest
+type CreateHallResponse CommonHallResponse
+type UpdateHallRequest CommonHallRequest
+type UpdateHallResponse CommonHallResponse
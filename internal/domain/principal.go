@@ -0,0 +1,34 @@
+package domain
+
+import "context"
+
+// AuthMethod is how a caller proved who they are.
+type AuthMethod string
+
+const (
+	AuthToken  AuthMethod = "token"
+	AuthAPIKey AuthMethod = "apiKey"
+)
+
+// Principal is the authenticated caller of a request, with the roles
+// assigned to them.
+type Principal struct {
+	Subject string           `json:"subject"`
+	Name    string           `json:"name,omitempty"`
+	Method  AuthMethod       `json:"method"`
+	Roles   []RoleAssignment `json:"roles"`
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a context carrying the authenticated caller.
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom returns the authenticated caller of ctx, or nil for
+// anonymous requests.
+func PrincipalFrom(ctx context.Context) *Principal {
+	principal, _ := ctx.Value(principalKey{}).(*Principal)
+	return principal
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// PublicationStatus is where an event is in its publishing workflow. Only
+// published events are listed to the public.
+type PublicationStatus string
+
+const (
+	StatusDraft     PublicationStatus = "draft"
+	StatusScheduled PublicationStatus = "scheduled" // Published once publishAt has passed
+	StatusPublished PublicationStatus = "published" // Archived once unpublishAt has passed
+	StatusArchived  PublicationStatus = "archived"
+)
+
+func (s PublicationStatus) IsValid() bool {
+	switch s {
+	case StatusDraft, StatusScheduled, StatusPublished, StatusArchived:
+		return true
+	}
+	return false
+}
+
+// statusChanges lists the statuses an editor may move an event to from
+// each status. Any event can be taken back to draft.
+var statusChanges = map[PublicationStatus][]PublicationStatus{
+	StatusDraft:     {StatusScheduled, StatusPublished, StatusArchived},
+	StatusScheduled: {StatusDraft, StatusPublished, StatusArchived},
+	StatusPublished: {StatusDraft, StatusArchived},
+	StatusArchived:  {StatusDraft},
+}
+
+// CanChangeTo reports whether an editor may move an event from s to next.
+// Keeping the status is always allowed.
+func (s PublicationStatus) CanChangeTo(next PublicationStatus) bool {
+	if s == next {
+		return true
+	}
+
+	for _, allowed := range statusChanges[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ScheduledStatus returns the status the event has at now by its schedule:
+// a scheduled event is published once publishAt has passed, and a
+// published one archived once unpublishAt has. Drafts never change on
+// their own.
+func (b *EventBase) ScheduledStatus(now time.Time) PublicationStatus {
+	status := b.Status
+	if status == StatusScheduled && b.PublishAt != nil && !b.PublishAt.After(now) {
+		status = StatusPublished
+	}
+	if status == StatusPublished && b.UnpublishAt != nil && !b.UnpublishAt.After(now) {
+		status = StatusArchived
+	}
+
+	return status
+}
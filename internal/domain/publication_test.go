@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduledStatus(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	tests := []struct {
+		name string
+		base EventBase
+		want PublicationStatus
+	}{
+		{
+			name: "Scheduled before publishAt",
+			base: EventBase{Status: StatusScheduled, PublishAt: &future},
+			want: StatusScheduled,
+		},
+		{
+			name: "Scheduled at publishAt",
+			base: EventBase{Status: StatusScheduled, PublishAt: &now},
+			want: StatusPublished,
+		},
+		{
+			name: "Published past unpublishAt",
+			base: EventBase{Status: StatusPublished, UnpublishAt: &past},
+			want: StatusArchived,
+		},
+		{
+			name: "Scheduled past both",
+			base: EventBase{Status: StatusScheduled, PublishAt: &past, UnpublishAt: &now},
+			want: StatusArchived,
+		},
+		{
+			name: "Drafts stay drafts",
+			base: EventBase{Status: StatusDraft, PublishAt: &past},
+			want: StatusDraft,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.base.ScheduledStatus(now))
+		})
+	}
+}
+
+func TestCanChangeTo(t *testing.T) {
+	assert.True(t, StatusDraft.CanChangeTo(StatusScheduled))
+	assert.True(t, StatusPublished.CanChangeTo(StatusPublished))
+	assert.True(t, StatusArchived.CanChangeTo(StatusDraft))
+	assert.False(t, StatusPublished.CanChangeTo(StatusScheduled))
+	assert.False(t, StatusArchived.CanChangeTo(StatusPublished))
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// RevisionInfo describes a stored revision of an event, the event as it was
+// at one version. Revisions are numbered by that version.
+type RevisionInfo struct {
+	Number    int       `json:"revision" bson:"revision"`
+	UpdatedAt time.Time `json:"updatedAt" bson:"updatedAt"`
+	UpdatedBy string    `json:"updatedBy" bson:"updatedBy"`
+}
+
+// RevisionDiff lists the fields that differ between two revisions.
+type RevisionDiff struct {
+	From    int           `json:"from"`
+	To      int           `json:"to"`
+	Changes []FieldChange `json:"changes"`
+}
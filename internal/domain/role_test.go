@@ -0,0 +1,34 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrincipalCan(t *testing.T) {
+	theatreEditor := &Principal{Roles: []RoleAssignment{{Role: RoleEditor, EventTypes: []EventType{EventTypePerformance}}}}
+	publisher := &Principal{Roles: []RoleAssignment{{Role: RolePublisher}}}
+
+	tests := []struct {
+		name       string
+		principal  *Principal
+		permission Permission
+		eventType  EventType
+		want       bool
+	}{
+		{name: "Anonymous", principal: nil, permission: PermissionRead, eventType: EventTypeMovie, want: false},
+		{name: "Editor of the type", principal: theatreEditor, permission: PermissionWrite, eventType: EventTypePerformance, want: true},
+		{name: "Editor of another type", principal: theatreEditor, permission: PermissionWrite, eventType: EventTypeMovie, want: false},
+		{name: "Editor can't publish", principal: theatreEditor, permission: PermissionPublish, eventType: EventTypePerformance, want: false},
+		{name: "Scoped roles don't cover venues", principal: theatreEditor, permission: PermissionWrite, eventType: "", want: false},
+		{name: "Catalog-wide publisher", principal: publisher, permission: PermissionDelete, eventType: EventTypeMovie, want: true},
+		{name: "Publisher can't manage", principal: publisher, permission: PermissionManage, eventType: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.principal.Can(tt.permission, tt.eventType))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ShowtimeStatus string
+
+const (
+	ShowtimeScheduled ShowtimeStatus = "scheduled"
+	ShowtimeSoldOut   ShowtimeStatus = "soldOut"
+	ShowtimeCancelled ShowtimeStatus = "cancelled"
+)
+
+func (s ShowtimeStatus) IsValid() bool {
+	switch s {
+	case ShowtimeScheduled, ShowtimeSoldOut, ShowtimeCancelled:
+		return true
+	}
+	return false
+}
+
+type PriceTier struct {
+	Category string  `json:"category" bson:"category"`
+	Price    float64 `json:"price" bson:"price"`
+	Currency string  `json:"currency" bson:"currency"`
+}
+
+type CommonShowtimeRequest struct {
+	EventID    primitive.ObjectID `json:"eventId" bson:"eventId"`
+	EventType  EventType          `json:"eventType" bson:"eventType"`
+	VenueID    primitive.ObjectID `json:"venueId" bson:"venueId"`
+	HallID     primitive.ObjectID `json:"hallId" bson:"hallId"`
+	StartTime  time.Time          `json:"startTime" bson:"startTime"`
+	EndTime    time.Time          `json:"endTime" bson:"endTime"`
+	PriceTiers []PriceTier        `json:"priceTiers" bson:"priceTiers"`
+	Status     ShowtimeStatus     `json:"status" bson:"status"`
+}
+
+type CommonShowtimeResponse struct {
+	ID         primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	EventID    primitive.ObjectID `json:"eventId" bson:"eventId"`
+	EventType  EventType          `json:"eventType" bson:"eventType"`
+	VenueID    primitive.ObjectID `json:"venueId" bson:"venueId"`
+	HallID     primitive.ObjectID `json:"hallId" bson:"hallId"`
+	StartTime  time.Time          `json:"startTime" bson:"startTime"`
+	EndTime    time.Time          `json:"endTime" bson:"endTime"`
+	PriceTiers []PriceTier        `json:"priceTiers" bson:"priceTiers"`
+	Status     ShowtimeStatus     `json:"status" bson:"status"`
+}
+
+type GetShowtimeResponse CommonShowtimeResponse
+type CreateShowtimeRequest CommonShowtimeRequest
+type CreateShowtimeResponse CommonShowtimeResponse
+type UpdateShowtimeRequest CommonShowtimeRequest
+type UpdateShowtimeResponse CommonShowtimeResponse
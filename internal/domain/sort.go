@@ -0,0 +1,94 @@
+package domain
+
+import "strings"
+
+// maxSortKeys bounds the number of fields a list can be sorted by.
+const maxSortKeys = 3
+
+type SortKey struct {
+	Field      string // Stored field name
+	Descending bool
+}
+
+// Sort orders a list by its keys in turn. A nil Sort keeps the default
+// order of the list.
+type Sort []SortKey
+
+// ParseSort reads a sort expression such as "-releaseDate,name". Each name
+// must be in fields, which maps the names clients use to stored fields; a
+// leading "-" sorts that field in descending order.
+func ParseSort(value string, fields map[string]string) (Sort, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	names := strings.Split(value, ",")
+	if len(names) > maxSortKeys {
+		return nil, ErrInvalidSort
+	}
+
+	sort := make(Sort, 0, len(names))
+	seen := map[string]bool{}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+
+		key := SortKey{}
+		if strings.HasPrefix(name, "-") {
+			key.Descending = true
+			name = name[1:]
+		}
+
+		field, ok := fields[name]
+		if !ok || seen[field] {
+			return nil, ErrInvalidSort
+		}
+		seen[field] = true
+
+		key.Field = field
+		sort = append(sort, key)
+	}
+
+	return sort, nil
+}
+
+// eventSortFields are sortable on every event kind. "created" orders by the
+// creation time encoded in the ObjectID.
+var eventSortFields = map[string]string{
+	"name":       "name",
+	"popularity": "popularity",
+	"duration":   "duration",
+	"age":        "age",
+	"created":    "_id",
+}
+
+// SortFields returns the sort whitelist of the kind: the common event
+// fields and the kind's own dates.
+func (k EventKind) SortFields() map[string]string {
+	fields := make(map[string]string, len(eventSortFields)+2)
+	for name, field := range eventSortFields {
+		fields[name] = field
+	}
+	if k.StartDateField != "" {
+		fields[k.StartDateField] = k.StartDateField
+		fields[k.EndDateField] = k.EndDateField
+	}
+
+	return fields
+}
+
+// FeedSortFields is the sort whitelist of the event feed, the fields all
+// kinds share.
+func FeedSortFields() map[string]string {
+	return EventKind{}.SortFields()
+}
+
+var venueSortFields = map[string]string{
+	"name":    "name",
+	"city":    "city",
+	"created": "_id",
+}
+
+// VenueSortFields is the sort whitelist of the venue list.
+func VenueSortFields() map[string]string {
+	return venueSortFields
+}
@@ -0,0 +1,21 @@
+package domain
+
+type SportEvent struct {
+	EventBase `bson:",inline"`
+	Teams     []string `json:"teams" bson:"teams"`
+	League    string   `json:"league" bson:"league"`
+}
+
+func (*SportEvent) Kind() EventKind {
+	return EventKind{
+		Type:         EventTypeSport,
+		Name:         "Sport event",
+		Plural:       "sportEvents",
+		SearchFields: []string{"name", "teams", "league"},
+		MatchAllTags: true,
+	}
+}
+
+func (s *SportEvent) AlternateNames() []string {
+	return s.Teams
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UserSubjectPrefix starts the subject of the tokens issued to users, so
+// that they can't be mistaken for those of another issuer or of API keys.
+const UserSubjectPrefix = "user:"
+
+// User is a customer account. Emails are stored lowercased and are unique.
+type User struct {
+	ID           primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Email        string             `json:"email" bson:"email"`
+	Name         string             `json:"name" bson:"name"`
+	PasswordHash string             `json:"-" bson:"passwordHash"`
+	CreatedAt    time.Time          `json:"createdAt" bson:"createdAt"`
+	UpdatedAt    time.Time          `json:"updatedAt" bson:"updatedAt"`
+}
+
+// Subject is the subject of the tokens issued to the user.
+func (u *User) Subject() string {
+	return UserSubjectPrefix + u.ID.Hex()
+}
+
+type RegisterRequest struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// TokenPair is what a login or refresh returns. ExpiresIn is the lifetime
+// of the access token in seconds.
+type TokenPair struct {
+	AccessToken  string `json:"accessToken"`
+	RefreshToken string `json:"refreshToken"`
+	TokenType    string `json:"tokenType"`
+	ExpiresIn    int    `json:"expiresIn"`
+}
+
+type UserTokenKind string
+
+const (
+	UserTokenRefresh       UserTokenKind = "refresh"
+	UserTokenPasswordReset UserTokenKind = "passwordReset"
+)
+
+// UserToken is a single-use secret handed to a user: a refresh token or a
+// password reset token. Only its hash is stored. The refresh tokens that
+// replace one another share a family, so that reusing a replaced token
+// revokes the whole chain.
+type UserToken struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"userId"`
+	Kind      UserTokenKind      `bson:"kind"`
+	Hash      string             `bson:"hash"`
+	Family    primitive.ObjectID `bson:"family,omitempty"`
+	CreatedAt time.Time          `bson:"createdAt"`
+	ExpiresAt time.Time          `bson:"expiresAt"`
+	UsedAt    *time.Time         `bson:"usedAt,omitempty"`
+	RevokedAt *time.Time         `bson:"revokedAt,omitempty"`
+}
+
+// Notification is a message to a user, such as a password reset link.
+type Notification struct {
+	To      string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FieldError describes why one field of a request was rejected. Field is
+// the JSON path of the field, e.g. "lineup[0].name".
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// ValidationError lists every rejected field of a request, so that clients
+// can fix them all at once. It matches ErrValidation.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		fields = append(fields, field.Field)
+	}
+
+	return fmt.Sprintf("%v: %s", ErrValidation, strings.Join(fields, ", "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrValidation
+}
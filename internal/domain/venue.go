@@ -0,0 +1,48 @@
+package domain
+
+import "go.mongodb.org/mongo-driver/bson/primitive"
+
+// GeoPoint is a GeoJSON point, coordinates are [longitude, latitude].
+type GeoPoint struct {
+	Type        string    `json:"type" bson:"type"`
+	Coordinates []float64 `json:"coordinates" bson:"coordinates"`
+}
+
+func NewGeoPoint(longitude, latitude float64) GeoPoint {
+	return GeoPoint{Type: "Point", Coordinates: []float64{longitude, latitude}}
+}
+
+func (p GeoPoint) IsValid() bool {
+	if p.Type != "Point" || len(p.Coordinates) != 2 {
+		return false
+	}
+	longitude, latitude := p.Coordinates[0], p.Coordinates[1]
+	return longitude >= -180 && longitude <= 180 && latitude >= -90 && latitude <= 90
+}
+
+type CommonVenueRequest struct {
+	Cover       string   `json:"cover" bson:"cover"`
+	Name        string   `json:"name" bson:"name"`
+	Description string   `json:"description" bson:"description"`
+	Address     string   `json:"address" bson:"address"`
+	City        string   `json:"city" bson:"city"`
+	Phone       string   `json:"phone" bson:"phone"`
+	Location    GeoPoint `json:"location" bson:"location"`
+}
+
+type CommonVenueResponse struct {
+	ID          primitive.ObjectID `json:"_id,omitempty" bson:"_id,omitempty"`
+	Cover       string             `json:"cover" bson:"cover"`
+	Name        string             `json:"name" bson:"name"`
+	Description string             `json:"description" bson:"description"`
+	Address     string             `json:"address" bson:"address"`
+	City        string             `json:"city" bson:"city"`
+	Phone       string             `json:"phone" bson:"phone"`
+	Location    GeoPoint           `json:"location" bson:"location"`
+}
+
+type GetVenueResponse CommonVenueResponse
+type CreateVenueRequest CommonVenueRequest
+type CreateVenueResponse CommonVenueResponse
+type UpdateVenueRequest CommonVenueRequest
+type UpdateVenueResponse CommonVenueResponse
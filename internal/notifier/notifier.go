@@ -0,0 +1,85 @@
+// Package notifier delivers messages to users. The log and file notifiers
+// are meant for local development, where no mail is sent and the messages
+// are read from the log or from a file instead.
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"events/internal/domain"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+type Notifier interface {
+	Notify(ctx context.Context, notification domain.Notification) error
+}
+
+// New returns the notifier of the given kind: "log", or "file" appending
+// to path.
+func New(kind, path string) (Notifier, error) {
+	switch kind {
+	case "", "log":
+		return LogNotifier{}, nil
+	case "file":
+		return NewFileNotifier(path), nil
+	}
+
+	return nil, fmt.Errorf("unknown notifier %q", kind)
+}
+
+// LogNotifier writes messages to the application log.
+type LogNotifier struct{}
+
+func (LogNotifier) Notify(ctx context.Context, notification domain.Notification) error {
+	slog.Info("Notification",
+		slog.String("to", notification.To),
+		slog.String("subject", notification.Subject),
+		slog.String("body", notification.Body))
+
+	return nil
+}
+
+// FileNotifier appends messages to a file as JSON lines.
+type FileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileNotifier(path string) *FileNotifier {
+	return &FileNotifier{path: path}
+}
+
+type fileNotification struct {
+	At      time.Time `json:"at"`
+	To      string    `json:"to"`
+	Subject string    `json:"subject"`
+	Body    string    `json:"body"`
+}
+
+func (n *FileNotifier) Notify(ctx context.Context, notification domain.Notification) error {
+	line, err := json.Marshal(fileNotification{
+		At:      time.Now().UTC(),
+		To:      notification.To,
+		Subject: notification.Subject,
+		Body:    notification.Body,
+	})
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	file, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(line, '\n'))
+	return err
+}
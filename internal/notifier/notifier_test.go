@@ -0,0 +1,43 @@
+package notifier_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"events/internal/domain"
+	"events/internal/notifier"
+)
+
+func TestFileNotifier(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+
+	fileNotifier, err := notifier.New("file", path)
+	require.NoError(t, err)
+
+	for _, to := range []string{"aylar@example.com", "merdan@example.com"} {
+		require.NoError(t, fileNotifier.Notify(context.Background(), domain.Notification{To: to, Subject: "Reset your password", Body: "evp_token"}))
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+
+	var last map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &last))
+	assert.Equal(t, "merdan@example.com", last["to"])
+	assert.Equal(t, "evp_token", last["body"])
+}
+
+func TestNewUnknownNotifier(t *testing.T) {
+	_, err := notifier.New("smtp", "")
+	assert.Error(t, err)
+}
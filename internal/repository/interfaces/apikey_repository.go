@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=apikey_repository.go -destination=mocks/apikey_repository_mock.go
+
+type APIKeyRepository interface {
+	Create(key *domain.APIKey) (*domain.APIKey, error)
+	GetByHash(hash string) (*domain.APIKey, error)
+	GetAll() ([]*domain.APIKey, error)
+	Revoke(id primitive.ObjectID) error
+}
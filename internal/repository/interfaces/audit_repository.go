@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=audit_repository.go -destination=mocks/audit_repository_mock.go
+
+type AuditRepository interface {
+	Record(entry *domain.AuditEntry) error
+	GetHistory(entityType domain.EventType, entityID primitive.ObjectID, page, pageSize int) ([]*domain.AuditEntry, error)
+	GetHistoryCount(entityType domain.EventType, entityID primitive.ObjectID) (int, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=booking_repository.go -destination=mocks/booking_repository_mock.go
+
+type BookingRepository interface {
+	GetBookingByID(id primitive.ObjectID) (*domain.GetBookingResponse, error)
+	GetTakenSeats(showtimeID primitive.ObjectID) ([]string, error)
+	HoldSeats(booking *domain.CreateBookingResponse) (*domain.CreateBookingResponse, error)
+	ConfirmBooking(id primitive.ObjectID, ticketCode string) (*domain.GetBookingResponse, error)
+	CancelBooking(id primitive.ObjectID) (*domain.GetBookingResponse, error)
+}
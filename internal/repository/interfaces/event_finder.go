@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=event_finder.go -destination=mocks/event_finder_mock.go
+
+// EventFinder looks up the fields common to all event kinds, for code that
+// references events without caring about their kind.
+type EventFinder interface {
+	GetBaseByID(id primitive.ObjectID) (*domain.EventBase, error)
+	AddPopularity(id primitive.ObjectID, delta int) error
+}
+
+// EventCatalog holds the finder of every registered event kind.
+type EventCatalog map[domain.EventType]EventFinder
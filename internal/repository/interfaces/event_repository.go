@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"events/internal/domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EventRepository is the storage contract shared by every event kind.
+type EventRepository[T any] interface {
+	EventFinder
+	GetAll(filter domain.EventFilter, sort domain.Sort, page, pageSize int) ([]*T, error)
+	GetTotalCount(filter domain.EventFilter) (int, error)
+	GetSearchCount(query string) (int, error)
+	GetFilteredCount(tags []string) (int, error)
+	GetSearchFacets(query string) (*domain.Facets, error)
+	GetFilteredFacets(tags []string) (*domain.Facets, error)
+	GetByID(id primitive.ObjectID) (*T, error)
+	Create(event *T) (*T, error)
+	Update(id primitive.ObjectID, event *T, version int) (*T, error)
+	Patch(id primitive.ObjectID, event *T, fields []string, version int) (*T, error)
+	Delete(id primitive.ObjectID, version int, actor string) error
+	Restore(id primitive.ObjectID, actor string) (*T, error)
+	GetTrash(page, pageSize int) ([]*T, error)
+	GetTrashCount() (int, error)
+	Purge(before time.Time) ([]primitive.ObjectID, error)
+	GetScheduleDue(now time.Time) ([]*T, error)
+	Search(query string, sort domain.Sort, page int, pageSize int) ([]*T, error)
+	FilterByTags(tags []string, sort domain.Sort, page int, pageSize int) ([]*T, error)
+	Suggest(query string, limit int) ([]*domain.Suggestion, error)
+	GetAllAfter(filter domain.EventFilter, after primitive.ObjectID, pageSize int) ([]*T, error)
+	SearchAfter(query string, after primitive.ObjectID, pageSize int) ([]*T, error)
+	FilterByTagsAfter(tags []string, after primitive.ObjectID, pageSize int) ([]*T, error)
+}
@@ -0,0 +1,9 @@
+package repository
+
+import "events/internal/domain"
+
+//go:generate mockgen -source=feed_repository.go -destination=mocks/feed_repository_mock.go
+
+type FeedRepository interface {
+	GetFeed(filter domain.FeedFilter, sort domain.Sort, page, pageSize int) ([]*domain.FeedItem, int, error)
+}
@@ -0,0 +1,26 @@
+package repository
+
+import "events/internal/domain"
+
+// The interfaces of this package are mocked with mockgen -source, which can't
+// read generic interfaces. Those are mocked in package mode instead, from the
+// instantiations below; nothing but the generated mocks uses them.
+
+//go:generate mockgen -destination=mocks/event_repository_mock.go -package=mock_repository events/internal/repository/interfaces MovieRepository,TheatreRepository
+//go:generate mockgen -destination=mocks/revision_repository_mock.go -package=mock_repository events/internal/repository/interfaces MovieRevisionRepository,TheatreRevisionRepository
+
+type MovieRepository interface {
+	EventRepository[domain.Movie]
+}
+
+type TheatreRepository interface {
+	EventRepository[domain.Performance]
+}
+
+type MovieRevisionRepository interface {
+	RevisionRepository[domain.Movie]
+}
+
+type TheatreRevisionRepository interface {
+	RevisionRepository[domain.Performance]
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=hall_repository.go -destination=mocks/hall_repository_mock.go
+
+type HallRepository interface {
+	GetHallsByVenue(venueID primitive.ObjectID) ([]*domain.GetHallResponse, error)
+	GetHallByID(id primitive.ObjectID) (*domain.GetHallResponse, error)
+	CreateHall(venueID primitive.ObjectID, request *domain.CreateHallRequest) (*domain.CreateHallResponse, error)
+	UpdateHall(id primitive.ObjectID, request *domain.UpdateHallRequest) (*domain.UpdateHallResponse, error)
+	DeleteHall(id primitive.ObjectID) error
+	DeleteHallsByVenue(venueID primitive.ObjectID) error
+}
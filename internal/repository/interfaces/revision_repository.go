@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// RevisionRepository keeps the previous versions of the events of one kind.
+// A revision is the event itself as it was stored, its version is the
+// revision number.
+type RevisionRepository[T any] interface {
+	Save(event *T) error
+	GetRevisions(entityID primitive.ObjectID, page, pageSize int) ([]*domain.RevisionInfo, error)
+	GetRevisionCount(entityID primitive.ObjectID) (int, error)
+	GetRevision(entityID primitive.ObjectID, number int) (*T, error)
+	DeleteRevisions(entityIDs []primitive.ObjectID) error
+}
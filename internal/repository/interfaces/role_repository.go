@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=role_repository.go -destination=mocks/role_repository_mock.go
+
+type RoleRepository interface {
+	Create(assignment *domain.RoleAssignment) (*domain.RoleAssignment, error)
+	GetBySubject(subject string) ([]*domain.RoleAssignment, error)
+	GetAll(page, pageSize int) ([]*domain.RoleAssignment, error)
+	GetTotalCount() (int, error)
+	Delete(id primitive.ObjectID) error
+}
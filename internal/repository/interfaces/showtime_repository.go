@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"events/internal/domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=showtime_repository.go -destination=mocks/showtime_repository_mock.go
+
+type ShowtimeRepository interface {
+	GetShowtimesByEvent(eventType domain.EventType, eventID primitive.ObjectID, from, to time.Time) ([]*domain.GetShowtimeResponse, error)
+	GetShowtimesInRange(from, to time.Time, page, pageSize int) ([]*domain.GetShowtimeResponse, error)
+	GetShowtimesInRangeCount(from, to time.Time) (int, error)
+	GetShowtimeByID(id primitive.ObjectID) (*domain.GetShowtimeResponse, error)
+	CreateShowtime(request *domain.CreateShowtimeRequest) (*domain.CreateShowtimeResponse, error)
+	UpdateShowtime(id primitive.ObjectID, request *domain.UpdateShowtimeRequest) (*domain.UpdateShowtimeResponse, error)
+	DeleteShowtime(id primitive.ObjectID) error
+	DeleteShowtimesByEvents(eventType domain.EventType, eventIDs []primitive.ObjectID) error
+}
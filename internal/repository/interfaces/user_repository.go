@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"events/internal/domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=user_repository.go -destination=mocks/user_repository_mock.go
+
+type UserRepository interface {
+	Create(user *domain.User) (*domain.User, error)
+	GetByID(id primitive.ObjectID) (*domain.User, error)
+	GetByEmail(email string) (*domain.User, error)
+	UpdatePassword(id primitive.ObjectID, passwordHash string) error
+}
+
+type UserTokenRepository interface {
+	Create(token *domain.UserToken) error
+	GetByHash(kind domain.UserTokenKind, hash string) (*domain.UserToken, error)
+	Use(kind domain.UserTokenKind, hash string, now time.Time) (*domain.UserToken, error)
+	RevokeFamily(family primitive.ObjectID, now time.Time) error
+	RevokeUserTokens(userID primitive.ObjectID, kind domain.UserTokenKind, now time.Time) error
+}
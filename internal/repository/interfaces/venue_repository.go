@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=venue_repository.go -destination=mocks/venue_repository_mock.go
+
+type VenueRepository interface {
+	GetAllVenues(sort domain.Sort, page, pageSize int) ([]*domain.GetVenueResponse, error)
+	GetTotalVenuesCount() (int, error)
+	GetVenueByID(id primitive.ObjectID) (*domain.GetVenueResponse, error)
+	CreateVenue(request *domain.CreateVenueRequest) (*domain.CreateVenueResponse, error)
+	UpdateVenue(id primitive.ObjectID, request *domain.UpdateVenueRequest) (*domain.UpdateVenueResponse, error)
+	DeleteVenue(id primitive.ObjectID) error
+}
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: booking_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	domain "events/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockBookingRepository is a mock of BookingRepository interface.
+type MockBookingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingRepositoryMockRecorder
+}
+
+// MockBookingRepositoryMockRecorder is the mock recorder for MockBookingRepository.
+type MockBookingRepositoryMockRecorder struct {
+	mock *MockBookingRepository
+}
+
+// NewMockBookingRepository creates a new mock instance.
+func NewMockBookingRepository(ctrl *gomock.Controller) *MockBookingRepository {
+	mock := &MockBookingRepository{ctrl: ctrl}
+	mock.recorder = &MockBookingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingRepository) EXPECT() *MockBookingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelBooking mocks base method.
+func (m *MockBookingRepository) CancelBooking(id primitive.ObjectID) (*domain.GetBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBooking", id)
+	ret0, _ := ret[0].(*domain.GetBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBooking indicates an expected call of CancelBooking.
+func (mr *MockBookingRepositoryMockRecorder) CancelBooking(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockBookingRepository)(nil).CancelBooking), id)
+}
+
+// ConfirmBooking mocks base method.
+func (m *MockBookingRepository) ConfirmBooking(id primitive.ObjectID, ticketCode string) (*domain.GetBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmBooking", id, ticketCode)
+	ret0, _ := ret[0].(*domain.GetBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmBooking indicates an expected call of ConfirmBooking.
+func (mr *MockBookingRepositoryMockRecorder) ConfirmBooking(id, ticketCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmBooking", reflect.TypeOf((*MockBookingRepository)(nil).ConfirmBooking), id, ticketCode)
+}
+
+// GetBookingByID mocks base method.
+func (m *MockBookingRepository) GetBookingByID(id primitive.ObjectID) (*domain.GetBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingByID", id)
+	ret0, _ := ret[0].(*domain.GetBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingByID indicates an expected call of GetBookingByID.
+func (mr *MockBookingRepositoryMockRecorder) GetBookingByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByID", reflect.TypeOf((*MockBookingRepository)(nil).GetBookingByID), id)
+}
+
+// GetTakenSeats mocks base method.
+func (m *MockBookingRepository) GetTakenSeats(showtimeID primitive.ObjectID) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTakenSeats", showtimeID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTakenSeats indicates an expected call of GetTakenSeats.
+func (mr *MockBookingRepositoryMockRecorder) GetTakenSeats(showtimeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTakenSeats", reflect.TypeOf((*MockBookingRepository)(nil).GetTakenSeats), showtimeID)
+}
+
+// HoldSeats mocks base method.
+func (m *MockBookingRepository) HoldSeats(booking *domain.CreateBookingResponse) (*domain.CreateBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldSeats", booking)
+	ret0, _ := ret[0].(*domain.CreateBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldSeats indicates an expected call of HoldSeats.
+func (mr *MockBookingRepositoryMockRecorder) HoldSeats(booking interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldSeats", reflect.TypeOf((*MockBookingRepository)(nil).HoldSeats), booking)
+}
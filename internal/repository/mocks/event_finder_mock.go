@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: event_finder.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	domain "events/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockEventFinder is a mock of EventFinder interface.
+type MockEventFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventFinderMockRecorder
+}
+
+// MockEventFinderMockRecorder is the mock recorder for MockEventFinder.
+type MockEventFinderMockRecorder struct {
+	mock *MockEventFinder
+}
+
+// NewMockEventFinder creates a new mock instance.
+func NewMockEventFinder(ctrl *gomock.Controller) *MockEventFinder {
+	mock := &MockEventFinder{ctrl: ctrl}
+	mock.recorder = &MockEventFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventFinder) EXPECT() *MockEventFinderMockRecorder {
+	return m.recorder
+}
+
+// AddPopularity mocks base method.
+func (m *MockEventFinder) AddPopularity(id primitive.ObjectID, delta int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPopularity", id, delta)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPopularity indicates an expected call of AddPopularity.
+func (mr *MockEventFinderMockRecorder) AddPopularity(id, delta interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPopularity", reflect.TypeOf((*MockEventFinder)(nil).AddPopularity), id, delta)
+}
+
+// GetBaseByID mocks base method.
+func (m *MockEventFinder) GetBaseByID(id primitive.ObjectID) (*domain.EventBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseByID", id)
+	ret0, _ := ret[0].(*domain.EventBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseByID indicates an expected call of GetBaseByID.
+func (mr *MockEventFinderMockRecorder) GetBaseByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseByID", reflect.TypeOf((*MockEventFinder)(nil).GetBaseByID), id)
+}
@@ -0,0 +1,776 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: events/internal/repository/interfaces (interfaces: MovieRepository,TheatreRepository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	domain "events/internal/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockMovieRepository is a mock of MovieRepository interface.
+type MockMovieRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieRepositoryMockRecorder
+}
+
+// MockMovieRepositoryMockRecorder is the mock recorder for MockMovieRepository.
+type MockMovieRepositoryMockRecorder struct {
+	mock *MockMovieRepository
+}
+
+// NewMockMovieRepository creates a new mock instance.
+func NewMockMovieRepository(ctrl *gomock.Controller) *MockMovieRepository {
+	mock := &MockMovieRepository{ctrl: ctrl}
+	mock.recorder = &MockMovieRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieRepository) EXPECT() *MockMovieRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPopularity mocks base method.
+func (m *MockMovieRepository) AddPopularity(arg0 primitive.ObjectID, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPopularity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPopularity indicates an expected call of AddPopularity.
+func (mr *MockMovieRepositoryMockRecorder) AddPopularity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPopularity", reflect.TypeOf((*MockMovieRepository)(nil).AddPopularity), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockMovieRepository) Create(arg0 *domain.Movie) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMovieRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMovieRepository)(nil).Create), arg0)
+}
+
+// Delete mocks base method.
+func (m *MockMovieRepository) Delete(arg0 primitive.ObjectID, arg1 int, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMovieRepositoryMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMovieRepository)(nil).Delete), arg0, arg1, arg2)
+}
+
+// FilterByTags mocks base method.
+func (m *MockMovieRepository) FilterByTags(arg0 []string, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterByTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterByTags indicates an expected call of FilterByTags.
+func (mr *MockMovieRepositoryMockRecorder) FilterByTags(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterByTags", reflect.TypeOf((*MockMovieRepository)(nil).FilterByTags), arg0, arg1, arg2, arg3)
+}
+
+// FilterByTagsAfter mocks base method.
+func (m *MockMovieRepository) FilterByTagsAfter(arg0 []string, arg1 primitive.ObjectID, arg2 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterByTagsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterByTagsAfter indicates an expected call of FilterByTagsAfter.
+func (mr *MockMovieRepositoryMockRecorder) FilterByTagsAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterByTagsAfter", reflect.TypeOf((*MockMovieRepository)(nil).FilterByTagsAfter), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockMovieRepository) GetAll(arg0 domain.EventFilter, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockMovieRepositoryMockRecorder) GetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockMovieRepository)(nil).GetAll), arg0, arg1, arg2, arg3)
+}
+
+// GetAllAfter mocks base method.
+func (m *MockMovieRepository) GetAllAfter(arg0 domain.EventFilter, arg1 primitive.ObjectID, arg2 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAfter indicates an expected call of GetAllAfter.
+func (mr *MockMovieRepositoryMockRecorder) GetAllAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAfter", reflect.TypeOf((*MockMovieRepository)(nil).GetAllAfter), arg0, arg1, arg2)
+}
+
+// GetBaseByID mocks base method.
+func (m *MockMovieRepository) GetBaseByID(arg0 primitive.ObjectID) (*domain.EventBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseByID", arg0)
+	ret0, _ := ret[0].(*domain.EventBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseByID indicates an expected call of GetBaseByID.
+func (mr *MockMovieRepositoryMockRecorder) GetBaseByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseByID", reflect.TypeOf((*MockMovieRepository)(nil).GetBaseByID), arg0)
+}
+
+// GetByID mocks base method.
+func (m *MockMovieRepository) GetByID(arg0 primitive.ObjectID) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockMovieRepositoryMockRecorder) GetByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMovieRepository)(nil).GetByID), arg0)
+}
+
+// GetFilteredCount mocks base method.
+func (m *MockMovieRepository) GetFilteredCount(arg0 []string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilteredCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilteredCount indicates an expected call of GetFilteredCount.
+func (mr *MockMovieRepositoryMockRecorder) GetFilteredCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredCount", reflect.TypeOf((*MockMovieRepository)(nil).GetFilteredCount), arg0)
+}
+
+// GetFilteredFacets mocks base method.
+func (m *MockMovieRepository) GetFilteredFacets(arg0 []string) (*domain.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilteredFacets", arg0)
+	ret0, _ := ret[0].(*domain.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilteredFacets indicates an expected call of GetFilteredFacets.
+func (mr *MockMovieRepositoryMockRecorder) GetFilteredFacets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredFacets", reflect.TypeOf((*MockMovieRepository)(nil).GetFilteredFacets), arg0)
+}
+
+// GetScheduleDue mocks base method.
+func (m *MockMovieRepository) GetScheduleDue(arg0 time.Time) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduleDue", arg0)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduleDue indicates an expected call of GetScheduleDue.
+func (mr *MockMovieRepositoryMockRecorder) GetScheduleDue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleDue", reflect.TypeOf((*MockMovieRepository)(nil).GetScheduleDue), arg0)
+}
+
+// GetSearchCount mocks base method.
+func (m *MockMovieRepository) GetSearchCount(arg0 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchCount indicates an expected call of GetSearchCount.
+func (mr *MockMovieRepositoryMockRecorder) GetSearchCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCount", reflect.TypeOf((*MockMovieRepository)(nil).GetSearchCount), arg0)
+}
+
+// GetSearchFacets mocks base method.
+func (m *MockMovieRepository) GetSearchFacets(arg0 string) (*domain.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchFacets", arg0)
+	ret0, _ := ret[0].(*domain.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchFacets indicates an expected call of GetSearchFacets.
+func (mr *MockMovieRepositoryMockRecorder) GetSearchFacets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchFacets", reflect.TypeOf((*MockMovieRepository)(nil).GetSearchFacets), arg0)
+}
+
+// GetTotalCount mocks base method.
+func (m *MockMovieRepository) GetTotalCount(arg0 domain.EventFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalCount indicates an expected call of GetTotalCount.
+func (mr *MockMovieRepositoryMockRecorder) GetTotalCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCount", reflect.TypeOf((*MockMovieRepository)(nil).GetTotalCount), arg0)
+}
+
+// GetTrash mocks base method.
+func (m *MockMovieRepository) GetTrash(arg0, arg1 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockMovieRepositoryMockRecorder) GetTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockMovieRepository)(nil).GetTrash), arg0, arg1)
+}
+
+// GetTrashCount mocks base method.
+func (m *MockMovieRepository) GetTrashCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashCount indicates an expected call of GetTrashCount.
+func (mr *MockMovieRepositoryMockRecorder) GetTrashCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashCount", reflect.TypeOf((*MockMovieRepository)(nil).GetTrashCount))
+}
+
+// Patch mocks base method.
+func (m *MockMovieRepository) Patch(arg0 primitive.ObjectID, arg1 *domain.Movie, arg2 []string, arg3 int) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockMovieRepositoryMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockMovieRepository)(nil).Patch), arg0, arg1, arg2, arg3)
+}
+
+// Purge mocks base method.
+func (m *MockMovieRepository) Purge(arg0 time.Time) ([]primitive.ObjectID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0)
+	ret0, _ := ret[0].([]primitive.ObjectID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockMovieRepositoryMockRecorder) Purge(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockMovieRepository)(nil).Purge), arg0)
+}
+
+// Restore mocks base method.
+func (m *MockMovieRepository) Restore(arg0 primitive.ObjectID, arg1 string) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockMovieRepositoryMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockMovieRepository)(nil).Restore), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockMovieRepository) Search(arg0 string, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockMovieRepositoryMockRecorder) Search(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockMovieRepository)(nil).Search), arg0, arg1, arg2, arg3)
+}
+
+// SearchAfter mocks base method.
+func (m *MockMovieRepository) SearchAfter(arg0 string, arg1 primitive.ObjectID, arg2 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAfter indicates an expected call of SearchAfter.
+func (mr *MockMovieRepositoryMockRecorder) SearchAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAfter", reflect.TypeOf((*MockMovieRepository)(nil).SearchAfter), arg0, arg1, arg2)
+}
+
+// Suggest mocks base method.
+func (m *MockMovieRepository) Suggest(arg0 string, arg1 int) ([]*domain.Suggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Suggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockMovieRepositoryMockRecorder) Suggest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockMovieRepository)(nil).Suggest), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockMovieRepository) Update(arg0 primitive.ObjectID, arg1 *domain.Movie, arg2 int) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMovieRepositoryMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMovieRepository)(nil).Update), arg0, arg1, arg2)
+}
+
+// MockTheatreRepository is a mock of TheatreRepository interface.
+type MockTheatreRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTheatreRepositoryMockRecorder
+}
+
+// MockTheatreRepositoryMockRecorder is the mock recorder for MockTheatreRepository.
+type MockTheatreRepositoryMockRecorder struct {
+	mock *MockTheatreRepository
+}
+
+// NewMockTheatreRepository creates a new mock instance.
+func NewMockTheatreRepository(ctrl *gomock.Controller) *MockTheatreRepository {
+	mock := &MockTheatreRepository{ctrl: ctrl}
+	mock.recorder = &MockTheatreRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTheatreRepository) EXPECT() *MockTheatreRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPopularity mocks base method.
+func (m *MockTheatreRepository) AddPopularity(arg0 primitive.ObjectID, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPopularity", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPopularity indicates an expected call of AddPopularity.
+func (mr *MockTheatreRepositoryMockRecorder) AddPopularity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPopularity", reflect.TypeOf((*MockTheatreRepository)(nil).AddPopularity), arg0, arg1)
+}
+
+// Create mocks base method.
+func (m *MockTheatreRepository) Create(arg0 *domain.Performance) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTheatreRepositoryMockRecorder) Create(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTheatreRepository)(nil).Create), arg0)
+}
+
+// Delete mocks base method.
+func (m *MockTheatreRepository) Delete(arg0 primitive.ObjectID, arg1 int, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTheatreRepositoryMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTheatreRepository)(nil).Delete), arg0, arg1, arg2)
+}
+
+// FilterByTags mocks base method.
+func (m *MockTheatreRepository) FilterByTags(arg0 []string, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterByTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterByTags indicates an expected call of FilterByTags.
+func (mr *MockTheatreRepositoryMockRecorder) FilterByTags(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterByTags", reflect.TypeOf((*MockTheatreRepository)(nil).FilterByTags), arg0, arg1, arg2, arg3)
+}
+
+// FilterByTagsAfter mocks base method.
+func (m *MockTheatreRepository) FilterByTagsAfter(arg0 []string, arg1 primitive.ObjectID, arg2 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterByTagsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterByTagsAfter indicates an expected call of FilterByTagsAfter.
+func (mr *MockTheatreRepositoryMockRecorder) FilterByTagsAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterByTagsAfter", reflect.TypeOf((*MockTheatreRepository)(nil).FilterByTagsAfter), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockTheatreRepository) GetAll(arg0 domain.EventFilter, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTheatreRepositoryMockRecorder) GetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTheatreRepository)(nil).GetAll), arg0, arg1, arg2, arg3)
+}
+
+// GetAllAfter mocks base method.
+func (m *MockTheatreRepository) GetAllAfter(arg0 domain.EventFilter, arg1 primitive.ObjectID, arg2 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAfter indicates an expected call of GetAllAfter.
+func (mr *MockTheatreRepositoryMockRecorder) GetAllAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAfter", reflect.TypeOf((*MockTheatreRepository)(nil).GetAllAfter), arg0, arg1, arg2)
+}
+
+// GetBaseByID mocks base method.
+func (m *MockTheatreRepository) GetBaseByID(arg0 primitive.ObjectID) (*domain.EventBase, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBaseByID", arg0)
+	ret0, _ := ret[0].(*domain.EventBase)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBaseByID indicates an expected call of GetBaseByID.
+func (mr *MockTheatreRepositoryMockRecorder) GetBaseByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBaseByID", reflect.TypeOf((*MockTheatreRepository)(nil).GetBaseByID), arg0)
+}
+
+// GetByID mocks base method.
+func (m *MockTheatreRepository) GetByID(arg0 primitive.ObjectID) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTheatreRepositoryMockRecorder) GetByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTheatreRepository)(nil).GetByID), arg0)
+}
+
+// GetFilteredCount mocks base method.
+func (m *MockTheatreRepository) GetFilteredCount(arg0 []string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilteredCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilteredCount indicates an expected call of GetFilteredCount.
+func (mr *MockTheatreRepositoryMockRecorder) GetFilteredCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredCount", reflect.TypeOf((*MockTheatreRepository)(nil).GetFilteredCount), arg0)
+}
+
+// GetFilteredFacets mocks base method.
+func (m *MockTheatreRepository) GetFilteredFacets(arg0 []string) (*domain.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilteredFacets", arg0)
+	ret0, _ := ret[0].(*domain.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilteredFacets indicates an expected call of GetFilteredFacets.
+func (mr *MockTheatreRepositoryMockRecorder) GetFilteredFacets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredFacets", reflect.TypeOf((*MockTheatreRepository)(nil).GetFilteredFacets), arg0)
+}
+
+// GetScheduleDue mocks base method.
+func (m *MockTheatreRepository) GetScheduleDue(arg0 time.Time) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduleDue", arg0)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduleDue indicates an expected call of GetScheduleDue.
+func (mr *MockTheatreRepositoryMockRecorder) GetScheduleDue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduleDue", reflect.TypeOf((*MockTheatreRepository)(nil).GetScheduleDue), arg0)
+}
+
+// GetSearchCount mocks base method.
+func (m *MockTheatreRepository) GetSearchCount(arg0 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchCount indicates an expected call of GetSearchCount.
+func (mr *MockTheatreRepositoryMockRecorder) GetSearchCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCount", reflect.TypeOf((*MockTheatreRepository)(nil).GetSearchCount), arg0)
+}
+
+// GetSearchFacets mocks base method.
+func (m *MockTheatreRepository) GetSearchFacets(arg0 string) (*domain.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchFacets", arg0)
+	ret0, _ := ret[0].(*domain.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchFacets indicates an expected call of GetSearchFacets.
+func (mr *MockTheatreRepositoryMockRecorder) GetSearchFacets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchFacets", reflect.TypeOf((*MockTheatreRepository)(nil).GetSearchFacets), arg0)
+}
+
+// GetTotalCount mocks base method.
+func (m *MockTheatreRepository) GetTotalCount(arg0 domain.EventFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalCount indicates an expected call of GetTotalCount.
+func (mr *MockTheatreRepositoryMockRecorder) GetTotalCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCount", reflect.TypeOf((*MockTheatreRepository)(nil).GetTotalCount), arg0)
+}
+
+// GetTrash mocks base method.
+func (m *MockTheatreRepository) GetTrash(arg0, arg1 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockTheatreRepositoryMockRecorder) GetTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockTheatreRepository)(nil).GetTrash), arg0, arg1)
+}
+
+// GetTrashCount mocks base method.
+func (m *MockTheatreRepository) GetTrashCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashCount indicates an expected call of GetTrashCount.
+func (mr *MockTheatreRepositoryMockRecorder) GetTrashCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashCount", reflect.TypeOf((*MockTheatreRepository)(nil).GetTrashCount))
+}
+
+// Patch mocks base method.
+func (m *MockTheatreRepository) Patch(arg0 primitive.ObjectID, arg1 *domain.Performance, arg2 []string, arg3 int) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockTheatreRepositoryMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockTheatreRepository)(nil).Patch), arg0, arg1, arg2, arg3)
+}
+
+// Purge mocks base method.
+func (m *MockTheatreRepository) Purge(arg0 time.Time) ([]primitive.ObjectID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0)
+	ret0, _ := ret[0].([]primitive.ObjectID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockTheatreRepositoryMockRecorder) Purge(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTheatreRepository)(nil).Purge), arg0)
+}
+
+// Restore mocks base method.
+func (m *MockTheatreRepository) Restore(arg0 primitive.ObjectID, arg1 string) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTheatreRepositoryMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTheatreRepository)(nil).Restore), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockTheatreRepository) Search(arg0 string, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockTheatreRepositoryMockRecorder) Search(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTheatreRepository)(nil).Search), arg0, arg1, arg2, arg3)
+}
+
+// SearchAfter mocks base method.
+func (m *MockTheatreRepository) SearchAfter(arg0 string, arg1 primitive.ObjectID, arg2 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAfter indicates an expected call of SearchAfter.
+func (mr *MockTheatreRepositoryMockRecorder) SearchAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAfter", reflect.TypeOf((*MockTheatreRepository)(nil).SearchAfter), arg0, arg1, arg2)
+}
+
+// Suggest mocks base method.
+func (m *MockTheatreRepository) Suggest(arg0 string, arg1 int) ([]*domain.Suggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Suggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockTheatreRepositoryMockRecorder) Suggest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockTheatreRepository)(nil).Suggest), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockTheatreRepository) Update(arg0 primitive.ObjectID, arg1 *domain.Performance, arg2 int) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTheatreRepositoryMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTheatreRepository)(nil).Update), arg0, arg1, arg2)
+}
@@ -0,0 +1,124 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: hall_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	domain "events/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockHallRepository is a mock of HallRepository interface.
+type MockHallRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHallRepositoryMockRecorder
+}
+
+// MockHallRepositoryMockRecorder is the mock recorder for MockHallRepository.
+type MockHallRepositoryMockRecorder struct {
+	mock *MockHallRepository
+}
+
+// NewMockHallRepository creates a new mock instance.
+func NewMockHallRepository(ctrl *gomock.Controller) *MockHallRepository {
+	mock := &MockHallRepository{ctrl: ctrl}
+	mock.recorder = &MockHallRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHallRepository) EXPECT() *MockHallRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateHall mocks base method.
+func (m *MockHallRepository) CreateHall(venueID primitive.ObjectID, request *domain.CreateHallRequest) (*domain.CreateHallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHall", venueID, request)
+	ret0, _ := ret[0].(*domain.CreateHallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHall indicates an expected call of CreateHall.
+func (mr *MockHallRepositoryMockRecorder) CreateHall(venueID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHall", reflect.TypeOf((*MockHallRepository)(nil).CreateHall), venueID, request)
+}
+
+// DeleteHall mocks base method.
+func (m *MockHallRepository) DeleteHall(id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHall", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHall indicates an expected call of DeleteHall.
+func (mr *MockHallRepositoryMockRecorder) DeleteHall(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHall", reflect.TypeOf((*MockHallRepository)(nil).DeleteHall), id)
+}
+
+// DeleteHallsByVenue mocks base method.
+func (m *MockHallRepository) DeleteHallsByVenue(venueID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHallsByVenue", venueID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHallsByVenue indicates an expected call of DeleteHallsByVenue.
+func (mr *MockHallRepositoryMockRecorder) DeleteHallsByVenue(venueID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHallsByVenue", reflect.TypeOf((*MockHallRepository)(nil).DeleteHallsByVenue), venueID)
+}
+
+// GetHallByID mocks base method.
+func (m *MockHallRepository) GetHallByID(id primitive.ObjectID) (*domain.GetHallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHallByID", id)
+	ret0, _ := ret[0].(*domain.GetHallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHallByID indicates an expected call of GetHallByID.
+func (mr *MockHallRepositoryMockRecorder) GetHallByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHallByID", reflect.TypeOf((*MockHallRepository)(nil).GetHallByID), id)
+}
+
+// GetHallsByVenue mocks base method.
+func (m *MockHallRepository) GetHallsByVenue(venueID primitive.ObjectID) ([]*domain.GetHallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHallsByVenue", venueID)
+	ret0, _ := ret[0].([]*domain.GetHallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHallsByVenue indicates an expected call of GetHallsByVenue.
+func (mr *MockHallRepositoryMockRecorder) GetHallsByVenue(venueID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHallsByVenue", reflect.TypeOf((*MockHallRepository)(nil).GetHallsByVenue), venueID)
+}
+
+// UpdateHall mocks base method.
+func (m *MockHallRepository) UpdateHall(id primitive.ObjectID, request *domain.UpdateHallRequest) (*domain.UpdateHallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHall", id, request)
+	ret0, _ := ret[0].(*domain.UpdateHallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHall indicates an expected call of UpdateHall.
+func (mr *MockHallRepositoryMockRecorder) UpdateHall(id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHall", reflect.TypeOf((*MockHallRepository)(nil).UpdateHall), id, request)
+}
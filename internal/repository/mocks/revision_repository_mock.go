@@ -0,0 +1,205 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: events/internal/repository/interfaces (interfaces: MovieRevisionRepository,TheatreRevisionRepository)
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	domain "events/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockMovieRevisionRepository is a mock of MovieRevisionRepository interface.
+type MockMovieRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieRevisionRepositoryMockRecorder
+}
+
+// MockMovieRevisionRepositoryMockRecorder is the mock recorder for MockMovieRevisionRepository.
+type MockMovieRevisionRepositoryMockRecorder struct {
+	mock *MockMovieRevisionRepository
+}
+
+// NewMockMovieRevisionRepository creates a new mock instance.
+func NewMockMovieRevisionRepository(ctrl *gomock.Controller) *MockMovieRevisionRepository {
+	mock := &MockMovieRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockMovieRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieRevisionRepository) EXPECT() *MockMovieRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteRevisions mocks base method.
+func (m *MockMovieRevisionRepository) DeleteRevisions(arg0 []primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRevisions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRevisions indicates an expected call of DeleteRevisions.
+func (mr *MockMovieRevisionRepositoryMockRecorder) DeleteRevisions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRevisions", reflect.TypeOf((*MockMovieRevisionRepository)(nil).DeleteRevisions), arg0)
+}
+
+// GetRevision mocks base method.
+func (m *MockMovieRevisionRepository) GetRevision(arg0 primitive.ObjectID, arg1 int) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockMovieRevisionRepositoryMockRecorder) GetRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockMovieRevisionRepository)(nil).GetRevision), arg0, arg1)
+}
+
+// GetRevisionCount mocks base method.
+func (m *MockMovieRevisionRepository) GetRevisionCount(arg0 primitive.ObjectID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionCount indicates an expected call of GetRevisionCount.
+func (mr *MockMovieRevisionRepositoryMockRecorder) GetRevisionCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionCount", reflect.TypeOf((*MockMovieRevisionRepository)(nil).GetRevisionCount), arg0)
+}
+
+// GetRevisions mocks base method.
+func (m *MockMovieRevisionRepository) GetRevisions(arg0 primitive.ObjectID, arg1, arg2 int) ([]*domain.RevisionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.RevisionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockMovieRevisionRepositoryMockRecorder) GetRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockMovieRevisionRepository)(nil).GetRevisions), arg0, arg1, arg2)
+}
+
+// Save mocks base method.
+func (m *MockMovieRevisionRepository) Save(arg0 *domain.Movie) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockMovieRevisionRepositoryMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockMovieRevisionRepository)(nil).Save), arg0)
+}
+
+// MockTheatreRevisionRepository is a mock of TheatreRevisionRepository interface.
+type MockTheatreRevisionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTheatreRevisionRepositoryMockRecorder
+}
+
+// MockTheatreRevisionRepositoryMockRecorder is the mock recorder for MockTheatreRevisionRepository.
+type MockTheatreRevisionRepositoryMockRecorder struct {
+	mock *MockTheatreRevisionRepository
+}
+
+// NewMockTheatreRevisionRepository creates a new mock instance.
+func NewMockTheatreRevisionRepository(ctrl *gomock.Controller) *MockTheatreRevisionRepository {
+	mock := &MockTheatreRevisionRepository{ctrl: ctrl}
+	mock.recorder = &MockTheatreRevisionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTheatreRevisionRepository) EXPECT() *MockTheatreRevisionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteRevisions mocks base method.
+func (m *MockTheatreRevisionRepository) DeleteRevisions(arg0 []primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRevisions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRevisions indicates an expected call of DeleteRevisions.
+func (mr *MockTheatreRevisionRepositoryMockRecorder) DeleteRevisions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRevisions", reflect.TypeOf((*MockTheatreRevisionRepository)(nil).DeleteRevisions), arg0)
+}
+
+// GetRevision mocks base method.
+func (m *MockTheatreRevisionRepository) GetRevision(arg0 primitive.ObjectID, arg1 int) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockTheatreRevisionRepositoryMockRecorder) GetRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockTheatreRevisionRepository)(nil).GetRevision), arg0, arg1)
+}
+
+// GetRevisionCount mocks base method.
+func (m *MockTheatreRevisionRepository) GetRevisionCount(arg0 primitive.ObjectID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionCount indicates an expected call of GetRevisionCount.
+func (mr *MockTheatreRevisionRepositoryMockRecorder) GetRevisionCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionCount", reflect.TypeOf((*MockTheatreRevisionRepository)(nil).GetRevisionCount), arg0)
+}
+
+// GetRevisions mocks base method.
+func (m *MockTheatreRevisionRepository) GetRevisions(arg0 primitive.ObjectID, arg1, arg2 int) ([]*domain.RevisionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.RevisionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockTheatreRevisionRepositoryMockRecorder) GetRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockTheatreRevisionRepository)(nil).GetRevisions), arg0, arg1, arg2)
+}
+
+// Save mocks base method.
+func (m *MockTheatreRevisionRepository) Save(arg0 *domain.Performance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockTheatreRevisionRepositoryMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockTheatreRevisionRepository)(nil).Save), arg0)
+}
@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: showtime_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	domain "events/internal/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockShowtimeRepository is a mock of ShowtimeRepository interface.
+type MockShowtimeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockShowtimeRepositoryMockRecorder
+}
+
+// MockShowtimeRepositoryMockRecorder is the mock recorder for MockShowtimeRepository.
+type MockShowtimeRepositoryMockRecorder struct {
+	mock *MockShowtimeRepository
+}
+
+// NewMockShowtimeRepository creates a new mock instance.
+func NewMockShowtimeRepository(ctrl *gomock.Controller) *MockShowtimeRepository {
+	mock := &MockShowtimeRepository{ctrl: ctrl}
+	mock.recorder = &MockShowtimeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShowtimeRepository) EXPECT() *MockShowtimeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateShowtime mocks base method.
+func (m *MockShowtimeRepository) CreateShowtime(request *domain.CreateShowtimeRequest) (*domain.CreateShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShowtime", request)
+	ret0, _ := ret[0].(*domain.CreateShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShowtime indicates an expected call of CreateShowtime.
+func (mr *MockShowtimeRepositoryMockRecorder) CreateShowtime(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShowtime", reflect.TypeOf((*MockShowtimeRepository)(nil).CreateShowtime), request)
+}
+
+// DeleteShowtime mocks base method.
+func (m *MockShowtimeRepository) DeleteShowtime(id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShowtime", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShowtime indicates an expected call of DeleteShowtime.
+func (mr *MockShowtimeRepositoryMockRecorder) DeleteShowtime(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShowtime", reflect.TypeOf((*MockShowtimeRepository)(nil).DeleteShowtime), id)
+}
+
+// DeleteShowtimesByEvents mocks base method.
+func (m *MockShowtimeRepository) DeleteShowtimesByEvents(eventType domain.EventType, eventIDs []primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShowtimesByEvents", eventType, eventIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShowtimesByEvents indicates an expected call of DeleteShowtimesByEvents.
+func (mr *MockShowtimeRepositoryMockRecorder) DeleteShowtimesByEvents(eventType, eventIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShowtimesByEvents", reflect.TypeOf((*MockShowtimeRepository)(nil).DeleteShowtimesByEvents), eventType, eventIDs)
+}
+
+// GetShowtimeByID mocks base method.
+func (m *MockShowtimeRepository) GetShowtimeByID(id primitive.ObjectID) (*domain.GetShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimeByID", id)
+	ret0, _ := ret[0].(*domain.GetShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShowtimeByID indicates an expected call of GetShowtimeByID.
+func (mr *MockShowtimeRepositoryMockRecorder) GetShowtimeByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimeByID", reflect.TypeOf((*MockShowtimeRepository)(nil).GetShowtimeByID), id)
+}
+
+// GetShowtimesByEvent mocks base method.
+func (m *MockShowtimeRepository) GetShowtimesByEvent(eventType domain.EventType, eventID primitive.ObjectID, from, to time.Time) ([]*domain.GetShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimesByEvent", eventType, eventID, from, to)
+	ret0, _ := ret[0].([]*domain.GetShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShowtimesByEvent indicates an expected call of GetShowtimesByEvent.
+func (mr *MockShowtimeRepositoryMockRecorder) GetShowtimesByEvent(eventType, eventID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimesByEvent", reflect.TypeOf((*MockShowtimeRepository)(nil).GetShowtimesByEvent), eventType, eventID, from, to)
+}
+
+// GetShowtimesInRange mocks base method.
+func (m *MockShowtimeRepository) GetShowtimesInRange(from, to time.Time, page, pageSize int) ([]*domain.GetShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimesInRange", from, to, page, pageSize)
+	ret0, _ := ret[0].([]*domain.GetShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShowtimesInRange indicates an expected call of GetShowtimesInRange.
+func (mr *MockShowtimeRepositoryMockRecorder) GetShowtimesInRange(from, to, page, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimesInRange", reflect.TypeOf((*MockShowtimeRepository)(nil).GetShowtimesInRange), from, to, page, pageSize)
+}
+
+// GetShowtimesInRangeCount mocks base method.
+func (m *MockShowtimeRepository) GetShowtimesInRangeCount(from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimesInRangeCount", from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShowtimesInRangeCount indicates an expected call of GetShowtimesInRangeCount.
+func (mr *MockShowtimeRepositoryMockRecorder) GetShowtimesInRangeCount(from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimesInRangeCount", reflect.TypeOf((*MockShowtimeRepository)(nil).GetShowtimesInRangeCount), from, to)
+}
+
+// UpdateShowtime mocks base method.
+func (m *MockShowtimeRepository) UpdateShowtime(id primitive.ObjectID, request *domain.UpdateShowtimeRequest) (*domain.UpdateShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShowtime", id, request)
+	ret0, _ := ret[0].(*domain.UpdateShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShowtime indicates an expected call of UpdateShowtime.
+func (mr *MockShowtimeRepositoryMockRecorder) UpdateShowtime(id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShowtime", reflect.TypeOf((*MockShowtimeRepository)(nil).UpdateShowtime), id, request)
+}
@@ -0,0 +1,125 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: venue_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	domain "events/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockVenueRepository is a mock of VenueRepository interface.
+type MockVenueRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockVenueRepositoryMockRecorder
+}
+
+// MockVenueRepositoryMockRecorder is the mock recorder for MockVenueRepository.
+type MockVenueRepositoryMockRecorder struct {
+	mock *MockVenueRepository
+}
+
+// NewMockVenueRepository creates a new mock instance.
+func NewMockVenueRepository(ctrl *gomock.Controller) *MockVenueRepository {
+	mock := &MockVenueRepository{ctrl: ctrl}
+	mock.recorder = &MockVenueRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVenueRepository) EXPECT() *MockVenueRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateVenue mocks base method.
+func (m *MockVenueRepository) CreateVenue(request *domain.CreateVenueRequest) (*domain.CreateVenueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVenue", request)
+	ret0, _ := ret[0].(*domain.CreateVenueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVenue indicates an expected call of CreateVenue.
+func (mr *MockVenueRepositoryMockRecorder) CreateVenue(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVenue", reflect.TypeOf((*MockVenueRepository)(nil).CreateVenue), request)
+}
+
+// DeleteVenue mocks base method.
+func (m *MockVenueRepository) DeleteVenue(id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVenue", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVenue indicates an expected call of DeleteVenue.
+func (mr *MockVenueRepositoryMockRecorder) DeleteVenue(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVenue", reflect.TypeOf((*MockVenueRepository)(nil).DeleteVenue), id)
+}
+
+// GetAllVenues mocks base method.
+func (m *MockVenueRepository) GetAllVenues(sort domain.Sort, page, pageSize int) ([]*domain.GetVenueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllVenues", sort, page, pageSize)
+	ret0, _ := ret[0].([]*domain.GetVenueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllVenues indicates an expected call of GetAllVenues.
+func (mr *MockVenueRepositoryMockRecorder) GetAllVenues(sort, page, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVenues", reflect.TypeOf((*MockVenueRepository)(nil).GetAllVenues), sort, page, pageSize)
+}
+
+// GetTotalVenuesCount mocks base method.
+func (m *MockVenueRepository) GetTotalVenuesCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalVenuesCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalVenuesCount indicates an expected call of GetTotalVenuesCount.
+func (mr *MockVenueRepositoryMockRecorder) GetTotalVenuesCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalVenuesCount", reflect.TypeOf((*MockVenueRepository)(nil).GetTotalVenuesCount))
+}
+
+// GetVenueByID mocks base method.
+func (m *MockVenueRepository) GetVenueByID(id primitive.ObjectID) (*domain.GetVenueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVenueByID", id)
+	ret0, _ := ret[0].(*domain.GetVenueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVenueByID indicates an expected call of GetVenueByID.
+func (mr *MockVenueRepositoryMockRecorder) GetVenueByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVenueByID", reflect.TypeOf((*MockVenueRepository)(nil).GetVenueByID), id)
+}
+
+// UpdateVenue mocks base method.
+func (m *MockVenueRepository) UpdateVenue(id primitive.ObjectID, request *domain.UpdateVenueRequest) (*domain.UpdateVenueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVenue", id, request)
+	ret0, _ := ret[0].(*domain.UpdateVenueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVenue indicates an expected call of UpdateVenue.
+func (mr *MockVenueRepositoryMockRecorder) UpdateVenue(id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVenue", reflect.TypeOf((*MockVenueRepository)(nil).UpdateVenue), id, request)
+}
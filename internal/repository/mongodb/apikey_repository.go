@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBAPIKeyRepository stores API keys by the hash of the key.
+// Revoked keys are kept so that their audit trail stays readable.
+type MongoDBAPIKeyRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDBAPIKeyRepository(collection *mongo.Collection) *MongoDBAPIKeyRepository {
+	return &MongoDBAPIKeyRepository{
+		collection: collection,
+	}
+}
+
+func (r *MongoDBAPIKeyRepository) EnsureIndexes() error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(context.Background(), indexes); err != nil {
+		slog.Error("error creating api key indexes", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBAPIKeyRepository) Create(key *domain.APIKey) (*domain.APIKey, error) {
+	key.ID = primitive.NilObjectID
+
+	result, err := r.collection.InsertOne(context.Background(), key)
+	if err != nil {
+		slog.Error("error inserting api key", utils.Err(err))
+		return nil, err
+	}
+
+	if id, ok := result.InsertedID.(primitive.ObjectID); ok {
+		key.ID = id
+	}
+
+	return key, nil
+}
+
+// GetByHash returns the active key with the given hash.
+func (r *MongoDBAPIKeyRepository) GetByHash(hash string) (*domain.APIKey, error) {
+	filter := bson.M{"hash": hash, "revokedAt": bson.M{"$exists": false}}
+
+	var key domain.APIKey
+	if err := r.collection.FindOne(context.Background(), filter).Decode(&key); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrAPIKeyNotFound
+		}
+		slog.Error("error finding api key", utils.Err(err))
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+func (r *MongoDBAPIKeyRepository) GetAll() ([]*domain.APIKey, error) {
+	ctx := context.Background()
+
+	opts := options.Find().SetSort(bson.D{{Key: "createdAt", Value: -1}, {Key: "_id", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		slog.Error("error finding api keys", utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	keys := []*domain.APIKey{}
+	if err := cursor.All(ctx, &keys); err != nil {
+		slog.Error("error decoding api keys", utils.Err(err))
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (r *MongoDBAPIKeyRepository) Revoke(id primitive.ObjectID) error {
+	filter := bson.M{"_id": id, "revokedAt": bson.M{"$exists": false}}
+	update := bson.M{"$set": bson.M{"revokedAt": time.Now().UTC()}}
+
+	result, err := r.collection.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		slog.Error("error revoking api key", utils.Err(err))
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBAuditRepository stores the audit log. Entries are only ever
+// appended.
+type MongoDBAuditRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDBAuditRepository(collection *mongo.Collection) (*MongoDBAuditRepository, error) {
+	// Changed values are stored as they were in JSON. Reading nested
+	// documents back as maps keeps them objects when they are served again.
+	collection, err := collection.Clone(options.Collection().SetBSONOptions(&options.BSONOptions{DefaultDocumentM: true}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &MongoDBAuditRepository{
+		collection: collection,
+	}, nil
+}
+
+func (r *MongoDBAuditRepository) EnsureIndexes() error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "entityType", Value: 1}, {Key: "entityId", Value: 1}, {Key: "at", Value: -1}}},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(context.Background(), indexes); err != nil {
+		slog.Error("error creating audit log indexes", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBAuditRepository) Record(entry *domain.AuditEntry) error {
+	entry.ID = primitive.NilObjectID
+
+	result, err := r.collection.InsertOne(context.Background(), entry)
+	if err != nil {
+		slog.Error("error inserting audit entry", utils.Err(err))
+		return err
+	}
+
+	if id, ok := result.InsertedID.(primitive.ObjectID); ok {
+		entry.ID = id
+	}
+
+	return nil
+}
+
+// GetHistory lists the changes of one event, newest first.
+func (r *MongoDBAuditRepository) GetHistory(entityType domain.EventType, entityID primitive.ObjectID, page, pageSize int) ([]*domain.AuditEntry, error) {
+	ctx := context.Background()
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "at", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	cursor, err := r.collection.Find(ctx, historyFilter(entityType, entityID), opts)
+	if err != nil {
+		slog.Error("error finding audit entries", utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	entries := []*domain.AuditEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		slog.Error("error decoding audit entries", utils.Err(err))
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (r *MongoDBAuditRepository) GetHistoryCount(entityType domain.EventType, entityID primitive.ObjectID) (int, error) {
+	count, err := r.collection.CountDocuments(context.Background(), historyFilter(entityType, entityID))
+	if err != nil {
+		slog.Error("error counting audit entries", utils.Err(err))
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func historyFilter(entityType domain.EventType, entityID primitive.ObjectID) bson.M {
+	return bson.M{"entityType": entityType, "entityId": entityID}
+}
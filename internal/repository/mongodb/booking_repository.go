@@ -0,0 +1,349 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBBookingRepository keeps bookings in one collection and one lock
+// document per taken seat in another. The unique (showtimeId, seat) index on
+// the locks is what prevents double-booking: concurrent holds for the same
+// seat race on the insert and only one of them can win.
+type MongoDBBookingRepository struct {
+	bookings  *mongo.Collection
+	seatLocks *mongo.Collection
+}
+
+func NewMongoDBBookingRepository(bookings, seatLocks *mongo.Collection) *MongoDBBookingRepository {
+	return &MongoDBBookingRepository{
+		bookings:  bookings,
+		seatLocks: seatLocks,
+	}
+}
+
+func (r *MongoDBBookingRepository) EnsureIndexes() error {
+	lockIndexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "showtimeId", Value: 1}, {Key: "seat", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{Keys: bson.D{{Key: "bookingId", Value: 1}}},
+		{
+			// Lets MongoDB release abandoned holds on its own. Confirmed
+			// seats have no expiresAt and are never removed by the TTL monitor.
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+
+	if _, err := r.seatLocks.Indexes().CreateMany(context.Background(), lockIndexes); err != nil {
+		slog.Error("error creating seat lock indexes", utils.Err(err))
+		return err
+	}
+
+	bookingIndex := mongo.IndexModel{Keys: bson.D{{Key: "showtimeId", Value: 1}, {Key: "status", Value: 1}}}
+
+	if _, err := r.bookings.Indexes().CreateOne(context.Background(), bookingIndex); err != nil {
+		slog.Error("error creating booking indexes", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBBookingRepository) GetBookingByID(id primitive.ObjectID) (*domain.GetBookingResponse, error) {
+	filter := bson.M{"_id": id}
+
+	var booking domain.GetBookingResponse
+
+	err := r.bookings.FindOne(context.Background(), filter).Decode(&booking)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		slog.Error("error getting booking by ID", utils.Err(err))
+		return nil, err
+	}
+
+	return &booking, nil
+}
+
+func (r *MongoDBBookingRepository) GetTakenSeats(showtimeID primitive.ObjectID) ([]string, error) {
+	filter := bson.M{
+		"showtimeId": showtimeID,
+		"$or": []bson.M{
+			{"expiresAt": bson.M{"$exists": false}},
+			{"expiresAt": bson.M{"$gt": time.Now()}},
+		},
+	}
+
+	opts := options.Find().SetProjection(bson.M{"seat": 1})
+
+	cursor, err := r.seatLocks.Find(context.Background(), filter, opts)
+	if err != nil {
+		slog.Error("error retrieving seat locks", utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	seats := []string{}
+	for cursor.Next(context.Background()) {
+		var lock domain.SeatLock
+		if err := cursor.Decode(&lock); err != nil {
+			slog.Error("error decoding seat lock", utils.Err(err))
+			return nil, err
+		}
+		seats = append(seats, lock.Seat)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return seats, nil
+}
+
+func (r *MongoDBBookingRepository) HoldSeats(booking *domain.CreateBookingResponse) (*domain.CreateBookingResponse, error) {
+	ctx := context.Background()
+
+	booking.ID = primitive.NewObjectID()
+
+	seats := make([]string, 0, len(booking.Seats))
+	locks := make([]interface{}, 0, len(booking.Seats))
+	for _, seat := range booking.Seats {
+		seats = append(seats, seat.Seat)
+		locks = append(locks, domain.SeatLock{
+			ShowtimeID: booking.ShowtimeID,
+			Seat:       seat.Seat,
+			BookingID:  booking.ID,
+			ExpiresAt:  booking.ExpiresAt,
+		})
+	}
+
+	// The TTL monitor only runs once a minute, so holds that have already
+	// expired are cleared here before they can block the new ones.
+	expired := bson.M{
+		"showtimeId": booking.ShowtimeID,
+		"seat":       bson.M{"$in": seats},
+		"expiresAt":  bson.M{"$lte": time.Now()},
+	}
+	if _, err := r.seatLocks.DeleteMany(ctx, expired); err != nil {
+		slog.Error("error releasing expired seat holds", utils.Err(err))
+		return nil, err
+	}
+
+	if _, err := r.seatLocks.InsertMany(ctx, locks); err != nil {
+		r.releaseSeats(booking.ID)
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, domain.ErrSeatsUnavailable
+		}
+		slog.Error("error locking seats", utils.Err(err))
+		return nil, err
+	}
+
+	if _, err := r.bookings.InsertOne(ctx, booking); err != nil {
+		r.releaseSeats(booking.ID)
+		slog.Error("error inserting booking document", utils.Err(err))
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+func (r *MongoDBBookingRepository) ConfirmBooking(id primitive.ObjectID, ticketCode string) (*domain.GetBookingResponse, error) {
+	ctx := context.Background()
+	now := time.Now()
+
+	// The booking is claimed before its locks are touched, so of two
+	// concurrent confirmations only the one that moved it out of held goes on.
+	claim := bson.M{"_id": id, "status": domain.BookingHeld, "expiresAt": bson.M{"$gt": now}}
+
+	booking, err := r.setStatus(claim, bson.M{
+		"status":      domain.BookingConfirmed,
+		"ticketCode":  ticketCode,
+		"confirmedAt": now,
+	})
+	if errors.Is(err, domain.ErrBookingNotHeld) {
+		return nil, r.confirmError(id, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Making the locks permanent is atomic per seat: a lock is either still
+	// alive and ours, or it has expired and may already belong to someone else.
+	lockFilter := bson.M{"bookingId": id, "expiresAt": bson.M{"$gt": now}}
+	result, err := r.seatLocks.UpdateMany(ctx, lockFilter, bson.M{"$unset": bson.M{"expiresAt": ""}})
+	if err != nil {
+		slog.Error("error confirming seat locks", utils.Err(err))
+		if abandonErr := r.abandonConfirmation(id, ticketCode, now); abandonErr != nil {
+			return nil, abandonErr
+		}
+		return nil, err
+	}
+
+	if int(result.ModifiedCount) != len(booking.Seats) {
+		if err := r.abandonConfirmation(id, ticketCode, now); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrHoldExpired
+	}
+
+	return booking, nil
+}
+
+// confirmError explains why a booking could not be claimed for confirmation.
+// A hold that ran out is expired here, by whoever wins that transition.
+func (r *MongoDBBookingRepository) confirmError(id primitive.ObjectID, now time.Time) error {
+	booking, err := r.GetBookingByID(id)
+	if err != nil {
+		return err
+	}
+	if booking == nil {
+		return domain.ErrBookingNotFound
+	}
+	if booking.Status != domain.BookingHeld {
+		return domain.ErrBookingNotHeld
+	}
+
+	expired := bson.M{"_id": id, "status": domain.BookingHeld, "expiresAt": bson.M{"$lte": now}}
+
+	won, err := r.expireBooking(expired)
+	if err != nil {
+		return err
+	}
+	if won {
+		if err := r.releaseExpiringSeats(id); err != nil {
+			return err
+		}
+	}
+
+	return domain.ErrHoldExpired
+}
+
+// abandonConfirmation expires a claimed booking whose hold ran out while its
+// locks were being made permanent. The locks it already made permanent get an
+// expiry back, so that only expiring locks are released. If the booking has
+// moved on in the meantime, e.g. it was cancelled, nothing is touched.
+func (r *MongoDBBookingRepository) abandonConfirmation(id primitive.ObjectID, ticketCode string, now time.Time) error {
+	claimed := bson.M{"_id": id, "status": domain.BookingConfirmed, "ticketCode": ticketCode}
+
+	won, err := r.expireBooking(claimed)
+	if err != nil || !won {
+		return err
+	}
+
+	permanent := bson.M{"bookingId": id, "expiresAt": bson.M{"$exists": false}}
+	if _, err := r.seatLocks.UpdateMany(context.Background(), permanent, bson.M{"$set": bson.M{"expiresAt": now}}); err != nil {
+		slog.Error("error restoring seat lock expiry", utils.Err(err))
+		return err
+	}
+
+	return r.releaseExpiringSeats(id)
+}
+
+func (r *MongoDBBookingRepository) CancelBooking(id primitive.ObjectID) (*domain.GetBookingResponse, error) {
+	now := time.Now()
+
+	filter := bson.M{
+		"_id":    id,
+		"status": bson.M{"$in": []domain.BookingStatus{domain.BookingHeld, domain.BookingConfirmed}},
+	}
+
+	update := bson.M{
+		"$set":   bson.M{"status": domain.BookingCancelled, "cancelledAt": now},
+		"$unset": bson.M{"expiresAt": ""},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var booking domain.GetBookingResponse
+
+	err := r.bookings.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&booking)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrBookingNotCancellable
+		}
+		slog.Error("error cancelling booking", utils.Err(err))
+		return nil, err
+	}
+
+	if err := r.releaseSeats(id); err != nil {
+		return nil, err
+	}
+
+	return &booking, nil
+}
+
+// setStatus applies fields to the booking matched by filter and drops its
+// expiry. It reports ErrBookingNotHeld if no booking matched.
+func (r *MongoDBBookingRepository) setStatus(filter, fields bson.M) (*domain.GetBookingResponse, error) {
+	update := bson.M{
+		"$set":   fields,
+		"$unset": bson.M{"expiresAt": ""},
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var booking domain.GetBookingResponse
+
+	err := r.bookings.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&booking)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrBookingNotHeld
+		}
+		slog.Error("error updating booking status", utils.Err(err))
+		return nil, err
+	}
+
+	return &booking, nil
+}
+
+// expireBooking moves the booking matched by filter to expired. It reports
+// whether this call made the transition, and with it the right to release the
+// booking's seats.
+func (r *MongoDBBookingRepository) expireBooking(filter bson.M) (bool, error) {
+	update := bson.M{
+		"$set":   bson.M{"status": domain.BookingExpired},
+		"$unset": bson.M{"expiresAt": "", "ticketCode": "", "confirmedAt": ""},
+	}
+
+	result, err := r.bookings.UpdateOne(context.Background(), filter, update)
+	if err != nil {
+		slog.Error("error expiring booking", utils.Err(err))
+		return false, err
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+func (r *MongoDBBookingRepository) releaseSeats(bookingID primitive.ObjectID) error {
+	if _, err := r.seatLocks.DeleteMany(context.Background(), bson.M{"bookingId": bookingID}); err != nil {
+		slog.Error("error releasing seats", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// releaseExpiringSeats deletes the booking's locks that still carry an
+// expiry. Permanent locks belong to a confirmed booking and are kept.
+func (r *MongoDBBookingRepository) releaseExpiringSeats(bookingID primitive.ObjectID) error {
+	filter := bson.M{"bookingId": bookingID, "expiresAt": bson.M{"$exists": true}}
+
+	if _, err := r.seatLocks.DeleteMany(context.Background(), filter); err != nil {
+		slog.Error("error releasing seats", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"events/internal/domain"
+)
+
+func TestConfirmBooking(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	id := primitive.NewObjectID()
+	booking := func(status domain.BookingStatus, expiresAt time.Time) bson.D {
+		return bson.D{
+			{Key: "_id", Value: id},
+			{Key: "status", Value: status},
+			{Key: "seats", Value: bson.A{bson.D{{Key: "seat", Value: "A-1"}}, bson.D{{Key: "seat", Value: "A-2"}}}},
+			{Key: "expiresAt", Value: expiresAt},
+		}
+	}
+	updated := func(n int) bson.D {
+		return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: n}, bson.E{Key: "nModified", Value: n})
+	}
+	claimed := mtest.CreateSuccessResponse(bson.E{Key: "value", Value: booking(domain.BookingConfirmed, time.Now())})
+	missed := mtest.CreateSuccessResponse(bson.E{Key: "value", Value: nil})
+	found := func(doc bson.D) bson.D {
+		return mtest.CreateCursorResponse(0, "test.bookings", mtest.FirstBatch, doc)
+	}
+
+	tests := []struct {
+		name      string
+		responses []bson.D
+		wantErr   error
+		// wantCommands lists the commands sent after the claim.
+		wantCommands []string
+	}{
+		{
+			name:         "All locks still held",
+			responses:    []bson.D{claimed, updated(2)},
+			wantCommands: []string{"update"},
+		},
+		{
+			name:         "Already confirmed by a concurrent request",
+			responses:    []bson.D{missed, found(booking(domain.BookingConfirmed, time.Now()))},
+			wantErr:      domain.ErrBookingNotHeld,
+			wantCommands: []string{"find"},
+		},
+		{
+			name:         "Hold expired before the claim",
+			responses:    []bson.D{missed, found(booking(domain.BookingHeld, time.Now().Add(-time.Minute))), updated(1), updated(2)},
+			wantErr:      domain.ErrHoldExpired,
+			wantCommands: []string{"find", "update", "delete"},
+		},
+		{
+			name:         "Hold expiry lost to a concurrent request",
+			responses:    []bson.D{missed, found(booking(domain.BookingHeld, time.Now().Add(-time.Minute))), updated(0)},
+			wantErr:      domain.ErrHoldExpired,
+			wantCommands: []string{"find", "update"},
+		},
+		{
+			name:         "Some locks expired while confirming",
+			responses:    []bson.D{claimed, updated(1), updated(1), updated(1), updated(1)},
+			wantErr:      domain.ErrHoldExpired,
+			wantCommands: []string{"update", "update", "update", "delete"},
+		},
+		{
+			name:         "Cancelled while confirming",
+			responses:    []bson.D{claimed, updated(1), updated(0)},
+			wantErr:      domain.ErrHoldExpired,
+			wantCommands: []string{"update", "update"},
+		},
+	}
+
+	for _, tt := range tests {
+		mt.Run(tt.name, func(mt *mtest.T) {
+			repo := NewMongoDBBookingRepository(mt.DB.Collection("bookings"), mt.DB.Collection("seatLocks"))
+			mt.AddMockResponses(tt.responses...)
+
+			got, err := repo.ConfirmBooking(id, "TICKET")
+
+			assert.ErrorIs(mt, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(mt, domain.BookingConfirmed, got.Status)
+			}
+
+			started := mt.GetAllStartedEvents()
+			assert.Equal(mt, "findAndModify", started[0].CommandName)
+
+			var commands []string
+			for _, event := range started[1:] {
+				commands = append(commands, event.CommandName)
+			}
+			assert.Equal(mt, tt.wantCommands, commands)
+
+			// Seats are only ever released through their expiring locks, so the
+			// permanent locks of a confirmed booking cannot be deleted.
+			for _, event := range started {
+				if event.CommandName != "delete" {
+					continue
+				}
+				filter := event.Command.Lookup("deletes", "0", "q")
+				assert.Equal(mt, "$exists", filter.Document().Lookup("expiresAt").Document().Index(0).Key())
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"events/internal/domain"
+)
+
+func TestEventFilter(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	hasMedia := true
+	durationMax, ageMax := 90, 12
+
+	tests := []struct {
+		name    string
+		kind    domain.EventKind
+		filter  domain.EventFilter
+		want    bson.M
+		wantErr error
+	}{
+		{
+			name:   "Empty filter matches everything",
+			kind:   (*domain.Movie)(nil).Kind(),
+			filter: domain.EventFilter{},
+			want:   bson.M{},
+		},
+		{
+			name: "Tag and category lists",
+			kind: (*domain.Movie)(nil).Kind(),
+			filter: domain.EventFilter{
+				TagsAll:       []string{"imax"},
+				TagsNone:      []string{"$where"},
+				CategoriesAny: []string{"Comedy", "Drama"},
+			},
+			want: bson.M{"$and": []bson.M{
+				{"tags": bson.M{"$all": []string{"imax"}}},
+				{"tags": bson.M{"$nin": []string{"$where"}}},
+				{"categories": bson.M{"$in": []string{"Comedy", "Drama"}}},
+			}},
+		},
+		{
+			name:   "Release range on a single date field",
+			kind:   (*domain.Movie)(nil).Kind(),
+			filter: domain.EventFilter{ReleaseFrom: &from, ReleaseTo: &to, HasMedia: &hasMedia},
+			want: bson.M{"$and": []bson.M{
+				{"releaseDate": bson.M{"$lt": to, "$gte": from}},
+				{"media.0": bson.M{"$exists": true}},
+			}},
+		},
+		{
+			name:   "Release range overlaps an open period",
+			kind:   (*domain.Exhibition)(nil).Kind(),
+			filter: domain.EventFilter{ReleaseFrom: &from, ReleaseTo: &to},
+			want: bson.M{"$and": []bson.M{
+				{"openFrom": bson.M{"$lt": to}, "openUntil": bson.M{"$gte": from}},
+			}},
+		},
+		{
+			name:   "Duration and age ranges",
+			kind:   (*domain.Performance)(nil).Kind(),
+			filter: domain.EventFilter{DurationMax: &durationMax, AgeMax: &ageMax},
+			want: bson.M{"$and": []bson.M{
+				{"duration": bson.M{"$lte": 90}},
+				{"age": bson.M{"$lte": 12}},
+			}},
+		},
+		{
+			name:    "Release range on an undated kind",
+			kind:    (*domain.Performance)(nil).Kind(),
+			filter:  domain.EventFilter{ReleaseFrom: &from},
+			wantErr: domain.ErrUnsupportedFilter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := eventFilter(tt.kind, tt.filter)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
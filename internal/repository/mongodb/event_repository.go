@@ -0,0 +1,991 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/normalize"
+	"events/pkg/lib/utils"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBEventRepository stores one event kind per collection. The kind of
+// T decides which fields the search matches and how tags are combined.
+type MongoDBEventRepository[T any, PT domain.EventPtr[T]] struct {
+	collection *mongo.Collection
+	kind       domain.EventKind
+}
+
+func NewMongoDBEventRepository[T any, PT domain.EventPtr[T]](collection *mongo.Collection) *MongoDBEventRepository[T, PT] {
+	return &MongoDBEventRepository[T, PT]{
+		collection: collection,
+		kind:       PT(new(T)).Kind(),
+	}
+}
+
+// EnsureIndexes creates the text index search relies on. Name-like fields
+// weigh the most, then tags, then the description. The language is "none"
+// since titles are mixed Turkmen, Russian and English and no single
+// stemmer fits them.
+func (r *MongoDBEventRepository[T, PT]) EnsureIndexes() error {
+	keys := bson.D{}
+	weights := bson.D{}
+	addField := func(field string, weight int) {
+		for _, key := range keys {
+			if key.Key == field {
+				return
+			}
+		}
+		keys = append(keys, bson.E{Key: field, Value: "text"})
+		weights = append(weights, bson.E{Key: field, Value: weight})
+	}
+
+	for _, field := range r.kind.SearchFields {
+		addField(field, 10)
+	}
+	addField("tags", 5)
+	addField("description", 1)
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys: keys,
+			Options: options.Index().
+				SetName("text_search").
+				SetWeights(weights).
+				SetDefaultLanguage("none"),
+		},
+		{Keys: bson.D{{Key: "searchKeys", Value: 1}}},
+		{Keys: bson.D{{Key: "name", Value: 1}}},
+		{Keys: bson.D{{Key: "popularity", Value: -1}}},
+		{Keys: bson.D{{Key: "duration", Value: 1}}},
+		{Keys: bson.D{{Key: "age", Value: 1}}},
+		{Keys: bson.D{{Key: "deletedAt", Value: -1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "publishAt", Value: 1}}},
+		{Keys: bson.D{{Key: "status", Value: 1}, {Key: "unpublishAt", Value: 1}}},
+	}
+	if r.kind.StartDateField != "" {
+		indexes = append(indexes, mongo.IndexModel{Keys: bson.D{{Key: r.kind.StartDateField, Value: -1}}})
+		if r.kind.EndDateField != r.kind.StartDateField {
+			indexes = append(indexes, mongo.IndexModel{Keys: bson.D{{Key: r.kind.EndDateField, Value: -1}}})
+		}
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(context.Background(), indexes); err != nil {
+		slog.Error("error creating event indexes", r.typeAttr(), utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// BackfillSearchNames fills the suggestion fields of events stored before
+// they were maintained.
+func (r *MongoDBEventRepository[T, PT]) BackfillSearchNames() error {
+	ctx := context.Background()
+
+	events, err := r.query(bson.M{"searchKeys": bson.M{"$exists": false}}, options.Find())
+	if err != nil {
+		return err
+	}
+
+	for _, event := range events {
+		base := r.setSearchNames(event)
+
+		update := bson.M{"$set": bson.M{"searchName": base.SearchName, "searchKeys": base.SearchKeys}}
+		if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": base.ID}, update); err != nil {
+			slog.Error("error backfilling event search names", r.typeAttr(), utils.Err(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BackfillVersions gives events stored before they were versioned their
+// first version.
+func (r *MongoDBEventRepository[T, PT]) BackfillVersions() error {
+	filter := bson.M{"version": bson.M{"$exists": false}}
+
+	if _, err := r.collection.UpdateMany(context.Background(), filter, bson.M{"$set": bson.M{"version": 1}}); err != nil {
+		slog.Error("error backfilling event versions", r.typeAttr(), utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// BackfillTimestamps dates events stored before timestamps were kept by the
+// creation time of their ObjectID.
+func (r *MongoDBEventRepository[T, PT]) BackfillTimestamps() error {
+	filter := bson.M{"createdAt": bson.M{"$exists": false}}
+	update := bson.A{bson.M{"$set": bson.M{
+		"createdAt": bson.M{"$toDate": "$_id"},
+		"updatedAt": bson.M{"$ifNull": bson.A{"$updatedAt", bson.M{"$toDate": "$_id"}}},
+	}}}
+
+	if _, err := r.collection.UpdateMany(context.Background(), filter, update); err != nil {
+		slog.Error("error backfilling event timestamps", r.typeAttr(), utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// BackfillStatuses publishes the events stored before the publishing
+// workflow, they were all public.
+func (r *MongoDBEventRepository[T, PT]) BackfillStatuses() error {
+	filter := bson.M{"status": bson.M{"$exists": false}}
+
+	if _, err := r.collection.UpdateMany(context.Background(), filter, bson.M{"$set": bson.M{"status": domain.StatusPublished}}); err != nil {
+		slog.Error("error backfilling event statuses", r.typeAttr(), utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// MigrateLegacyFields converts the free-form duration and age strings of
+// events stored before they were typed. Values that cannot be read are
+// cleared and kept in legacyDuration and legacyAge for manual review.
+func (r *MongoDBEventRepository[T, PT]) MigrateLegacyFields() error {
+	ctx := context.Background()
+
+	filter := bson.M{"$or": bson.A{
+		bson.M{"duration": bson.M{"$type": "string"}},
+		bson.M{"age": bson.M{"$type": "string"}},
+	}}
+	opts := options.Find().SetProjection(bson.M{"duration": 1, "age": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		slog.Error("error finding events with legacy fields", r.typeAttr(), utils.Err(err))
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		set := bson.M{}
+
+		if value, ok := cursor.Current.Lookup("duration").StringValueOK(); ok {
+			set["duration"] = nil
+			if minutes, err := domain.ParseMinutes(value); err == nil {
+				set["duration"] = minutes
+			} else if strings.TrimSpace(value) != "" {
+				set["legacyDuration"] = value
+			}
+		}
+
+		if value, ok := cursor.Current.Lookup("age").StringValueOK(); ok {
+			set["age"] = nil
+			if rating, err := domain.ParseAgeRating(value); err == nil {
+				set["age"] = rating
+			} else if strings.TrimSpace(value) != "" {
+				set["legacyAge"] = value
+			}
+		}
+
+		id := cursor.Current.Lookup("_id")
+		if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": set}); err != nil {
+			slog.Error("error migrating event legacy fields", r.typeAttr(), utils.Err(err))
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+func (r *MongoDBEventRepository[T, PT]) GetAll(filter domain.EventFilter, sort domain.Sort, page, pageSize int) ([]*T, error) {
+	query, err := eventFilter(r.kind, filter)
+	if err != nil {
+		return nil, err
+	}
+	query = withStatuses(query, filter.Statuses)
+
+	opts := options.Find().
+		SetSort(sortDocument(sort, bson.D{{Key: "_id", Value: 1}})).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	return r.find(query, opts)
+}
+
+func (r *MongoDBEventRepository[T, PT]) GetTotalCount(filter domain.EventFilter) (int, error) {
+	query, err := eventFilter(r.kind, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return r.count(withStatuses(query, filter.Statuses))
+}
+
+func (r *MongoDBEventRepository[T, PT]) GetSearchCount(query string) (int, error) {
+	return r.count(published(searchFilter(r.kind, query)))
+}
+
+func (r *MongoDBEventRepository[T, PT]) GetFilteredCount(tags []string) (int, error) {
+	return r.count(published(tagsFilter(r.kind, tags)))
+}
+
+// GetByID returns nil for events in the trash, like for missing ones. It
+// returns events of every publication status, so that they can be edited
+// and previewed.
+func (r *MongoDBEventRepository[T, PT]) GetByID(id primitive.ObjectID) (*T, error) {
+	filter := notDeleted(bson.M{"_id": id})
+
+	var event T
+
+	err := r.collection.FindOne(context.Background(), filter).Decode(&event)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		slog.Error("error getting event by ID", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+func (r *MongoDBEventRepository[T, PT]) GetBaseByID(id primitive.ObjectID) (*domain.EventBase, error) {
+	event, err := r.GetByID(id)
+	if err != nil || event == nil {
+		return nil, err
+	}
+
+	return PT(event).Base(), nil
+}
+
+func (r *MongoDBEventRepository[T, PT]) Create(event *T) (*T, error) {
+	base := r.setSearchNames(event)
+	base.ID = primitive.NilObjectID
+	base.Popularity = 0
+	base.Version = 1
+	base.CreatedAt = timestamp()
+	base.UpdatedAt = base.CreatedAt
+	base.DeletedAt = nil
+	base.DeletedBy = ""
+
+	result, err := r.collection.InsertOne(context.Background(), event)
+	if err != nil {
+		slog.Error("error inserting event document", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	insertedID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		slog.Error("error getting inserted event ID", r.typeAttr())
+		return nil, errors.New("error getting inserted event ID")
+	}
+
+	base.ID = insertedID
+
+	return event, nil
+}
+
+// readOnlyFields are maintained by the repository and never taken from an
+// update.
+var readOnlyFields = []string{"_id", "popularity", "version", "createdAt", "updatedAt", "createdBy", "updatedBy", "deletedAt", "deletedBy", "searchName", "searchKeys"}
+
+// Update replaces every field of the event. A non-zero version is the
+// version the client last read, the update fails with ErrVersionMismatch
+// when the event has changed since.
+func (r *MongoDBEventRepository[T, PT]) Update(id primitive.ObjectID, event *T, version int) (*T, error) {
+	r.setSearchNames(event)
+
+	fields, err := toDocument(event)
+	if err != nil {
+		slog.Error("error encoding event update", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+	for _, field := range readOnlyFields {
+		delete(fields, field)
+	}
+
+	return r.setFields(id, event, fields, version)
+}
+
+// Patch updates only the named top-level fields of the event, leaving the
+// stored values of all others untouched. Unknown and read-only fields are
+// rejected with ErrInvalidPatch. The version is checked as in Update.
+func (r *MongoDBEventRepository[T, PT]) Patch(id primitive.ObjectID, event *T, fields []string, version int) (*T, error) {
+	r.setSearchNames(event)
+
+	document, err := toDocument(event)
+	if err != nil {
+		slog.Error("error encoding event patch", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	patched := bson.M{}
+	for _, field := range fields {
+		value, ok := document[field]
+		if !ok || slices.Contains(readOnlyFields, field) {
+			return nil, fmt.Errorf("%w: field %q cannot be patched", domain.ErrInvalidPatch, field)
+		}
+		patched[field] = value
+	}
+
+	return r.setFields(id, event, patched, version)
+}
+
+// setFields sets fields, the folded names and updatedBy of event, bumps its
+// version and update time in one round trip, and returns the event as stored
+// afterwards.
+func (r *MongoDBEventRepository[T, PT]) setFields(id primitive.ObjectID, event *T, fields bson.M, version int) (*T, error) {
+	base := PT(event).Base()
+	fields["searchName"] = base.SearchName
+	fields["searchKeys"] = base.SearchKeys
+	fields["updatedAt"] = timestamp()
+	fields["updatedBy"] = base.UpdatedBy
+
+	update := bson.M{"$set": fields, "$inc": bson.M{"version": 1}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var updated T
+	err := r.collection.FindOneAndUpdate(context.Background(), notDeleted(versionFilter(id, version)), update, opts).Decode(&updated)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, r.missingOrModified(id, version)
+		}
+		slog.Error("error updating event", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// missingOrModified tells apart the two reasons a versioned write matched
+// nothing.
+func (r *MongoDBEventRepository[T, PT]) missingOrModified(id primitive.ObjectID, version int) error {
+	if version == 0 {
+		return domain.ErrEventNotFound
+	}
+
+	count, err := r.collection.CountDocuments(context.Background(), notDeleted(bson.M{"_id": id}), options.Count().SetLimit(1))
+	if err != nil {
+		slog.Error("error checking event existence", r.typeAttr(), utils.Err(err))
+		return err
+	}
+	if count == 0 {
+		return domain.ErrEventNotFound
+	}
+
+	return domain.ErrVersionMismatch
+}
+
+func (r *MongoDBEventRepository[T, PT]) AddPopularity(id primitive.ObjectID, delta int) error {
+	filter := notDeleted(bson.M{"_id": id})
+
+	_, err := r.collection.UpdateOne(context.Background(), filter, bson.M{"$inc": bson.M{"popularity": delta}})
+	if err != nil {
+		slog.Error("error updating event popularity", r.typeAttr(), utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// Delete moves the event to the trash, checking a non-zero version as in
+// Update. Trashed events are left out of every query until restored, and
+// purged for good once they have been in the trash for long enough.
+func (r *MongoDBEventRepository[T, PT]) Delete(id primitive.ObjectID, version int, actor string) error {
+	update := bson.M{
+		"$set": bson.M{"deletedAt": timestamp(), "deletedBy": actor},
+		"$inc": bson.M{"version": 1},
+	}
+
+	result, err := r.collection.UpdateOne(context.Background(), notDeleted(versionFilter(id, version)), update)
+	if err != nil {
+		slog.Error("error deleting event", r.typeAttr(), utils.Err(err))
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missingOrModified(id, version)
+	}
+
+	return nil
+}
+
+// Restore takes the event out of the trash and returns it as stored
+// afterwards. It fails with ErrEventNotFound unless the event is trashed.
+func (r *MongoDBEventRepository[T, PT]) Restore(id primitive.ObjectID, actor string) (*T, error) {
+	update := bson.M{
+		"$unset": bson.M{"deletedAt": "", "deletedBy": ""},
+		"$set":   bson.M{"updatedAt": timestamp(), "updatedBy": actor},
+		"$inc":   bson.M{"version": 1},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var restored T
+	err := r.collection.FindOneAndUpdate(context.Background(), deleted(bson.M{"_id": id}), update, opts).Decode(&restored)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrEventNotFound
+		}
+		slog.Error("error restoring event", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	return &restored, nil
+}
+
+// GetTrash lists the trashed events, most recently deleted first.
+func (r *MongoDBEventRepository[T, PT]) GetTrash(page, pageSize int) ([]*T, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "deletedAt", Value: -1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	return r.query(deleted(bson.M{}), opts)
+}
+
+func (r *MongoDBEventRepository[T, PT]) GetTrashCount() (int, error) {
+	total, err := r.collection.CountDocuments(context.Background(), deleted(bson.M{}))
+	if err != nil {
+		slog.Error("error counting trashed events", r.typeAttr(), utils.Err(err))
+		return 0, err
+	}
+
+	return int(total), nil
+}
+
+// Purge permanently removes the events trashed before the given time and
+// returns the IDs of those it removed, also when it fails part way.
+func (r *MongoDBEventRepository[T, PT]) Purge(before time.Time) ([]primitive.ObjectID, error) {
+	filter := bson.M{"deletedAt": bson.M{"$lt": before}}
+
+	cursor, err := r.collection.Find(context.Background(), filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		slog.Error("error finding events to purge", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	var documents []struct {
+		ID primitive.ObjectID `bson:"_id"`
+	}
+	if err := cursor.All(context.Background(), &documents); err != nil {
+		slog.Error("error decoding events to purge", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	// A restore may race with the lookup, so each deletion checks the trash
+	// marker again and only the events actually removed are reported.
+	ids := []primitive.ObjectID{}
+	for _, document := range documents {
+		purged := bson.M{"_id": document.ID, "deletedAt": bson.M{"$lt": before}}
+
+		result, err := r.collection.DeleteOne(context.Background(), purged)
+		if err != nil {
+			slog.Error("error purging event", r.typeAttr(), utils.Err(err))
+			return ids, err
+		}
+		if result.DeletedCount == 1 {
+			ids = append(ids, document.ID)
+		}
+	}
+
+	return ids, nil
+}
+
+// Search ranks text matches by relevance unless a sort is given. Short
+// queries, which the text index cannot match as words yet, fall back to
+// name prefixes in name order.
+func (r *MongoDBEventRepository[T, PT]) Search(query string, sort domain.Sort, page int, pageSize int) ([]*T, error) {
+	opts := options.Find().
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	switch {
+	case len(sort) > 0:
+		opts.SetSort(sortDocument(sort, nil))
+	case isTextQuery(query):
+		score := bson.M{"$meta": "textScore"}
+		opts.SetProjection(bson.M{"score": score}).
+			SetSort(bson.D{{Key: "score", Value: score}, {Key: "_id", Value: 1}})
+	default:
+		opts.SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}})
+	}
+
+	return r.find(published(searchFilter(r.kind, query)), opts)
+}
+
+// Suggest returns up to limit events with a name, or a word of a name, that
+// starts with the query after folding. Events whose own name starts with
+// it come first, then the more popular ones.
+func (r *MongoDBEventRepository[T, PT]) Suggest(query string, limit int) ([]*domain.Suggestion, error) {
+	folded := normalize.Name(query)
+	if folded == "" {
+		return []*domain.Suggestion{}, nil
+	}
+
+	prefix := "^" + regexp.QuoteMeta(folded)
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: notDeleted(published(bson.M{"searchKeys": bson.M{"$regex": prefix}}))}},
+		{{Key: "$addFields", Value: bson.M{
+			"nameMatch": bson.M{"$regexMatch": bson.M{"input": "$searchName", "regex": prefix}},
+		}}},
+		{{Key: "$sort", Value: bson.D{
+			{Key: "nameMatch", Value: -1},
+			{Key: "popularity", Value: -1},
+			{Key: "name", Value: 1},
+		}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: bson.M{"name": 1, "originalName": 1, "cover": 1}}},
+	}
+
+	cursor, err := r.collection.Aggregate(context.Background(), pipeline)
+	if err != nil {
+		slog.Error("error retrieving event suggestions", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	suggestions := []*domain.Suggestion{}
+	if err := cursor.All(context.Background(), &suggestions); err != nil {
+		slog.Error("error decoding event suggestions", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	return suggestions, nil
+}
+
+func (r *MongoDBEventRepository[T, PT]) FilterByTags(tags []string, sort domain.Sort, page int, pageSize int) ([]*T, error) {
+	opts := options.Find().
+		SetSort(sortDocument(sort, bson.D{{Key: "_id", Value: 1}})).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	return r.find(published(tagsFilter(r.kind, tags)), opts)
+}
+
+func (r *MongoDBEventRepository[T, PT]) GetAllAfter(filter domain.EventFilter, after primitive.ObjectID, pageSize int) ([]*T, error) {
+	query, err := eventFilter(r.kind, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.findAfter(withStatuses(query, filter.Statuses), after, pageSize)
+}
+
+func (r *MongoDBEventRepository[T, PT]) SearchAfter(query string, after primitive.ObjectID, pageSize int) ([]*T, error) {
+	return r.findAfter(published(searchFilter(r.kind, query)), after, pageSize)
+}
+
+func (r *MongoDBEventRepository[T, PT]) FilterByTagsAfter(tags []string, after primitive.ObjectID, pageSize int) ([]*T, error) {
+	return r.findAfter(published(tagsFilter(r.kind, tags)), after, pageSize)
+}
+
+// GetScheduleDue returns the events whose status their schedule has moved
+// on at now: scheduled events past publishAt and published events past
+// unpublishAt.
+func (r *MongoDBEventRepository[T, PT]) GetScheduleDue(now time.Time) ([]*T, error) {
+	filter := bson.M{"$or": []bson.M{
+		{"status": domain.StatusScheduled, "publishAt": bson.M{"$lte": now}},
+		{"status": domain.StatusPublished, "unpublishAt": bson.M{"$lte": now}},
+	}}
+
+	return r.find(filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+}
+
+// findAfter returns the next pageSize events matching the filter whose _id is
+// greater than after, in _id order. A zero after starts from the top.
+func (r *MongoDBEventRepository[T, PT]) findAfter(filter bson.M, after primitive.ObjectID, pageSize int) ([]*T, error) {
+	if !after.IsZero() {
+		filter = bson.M{"$and": []bson.M{filter, {"_id": bson.M{"$gt": after}}}}
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "_id", Value: 1}}).
+		SetLimit(int64(pageSize))
+
+	return r.find(filter, opts)
+}
+
+// find returns the events matching filter that are not in the trash.
+func (r *MongoDBEventRepository[T, PT]) find(filter bson.M, opts *options.FindOptions) ([]*T, error) {
+	return r.query(notDeleted(filter), opts)
+}
+
+func (r *MongoDBEventRepository[T, PT]) query(filter bson.M, opts *options.FindOptions) ([]*T, error) {
+	cursor, err := r.collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		slog.Error("error retrieving event list", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	var events []*T
+	for cursor.Next(context.Background()) {
+		var event T
+		if err := cursor.Decode(&event); err != nil {
+			slog.Error("error decoding event", r.typeAttr(), utils.Err(err))
+			return nil, err
+		}
+		events = append(events, &event)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (r *MongoDBEventRepository[T, PT]) GetSearchFacets(query string) (*domain.Facets, error) {
+	return r.facets(published(searchFilter(r.kind, query)))
+}
+
+func (r *MongoDBEventRepository[T, PT]) GetFilteredFacets(tags []string) (*domain.Facets, error) {
+	return r.facets(published(tagsFilter(r.kind, tags)))
+}
+
+// facets counts the events matching filter per category, tag, age rating
+// and, for dated kinds, year in one $facet aggregation.
+func (r *MongoDBEventRepository[T, PT]) facets(filter bson.M) (*domain.Facets, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: notDeleted(filter)}},
+		{{Key: "$facet", Value: facetStages(r.kind)}},
+	}
+
+	cursor, err := r.collection.Aggregate(context.Background(), pipeline)
+	if err != nil {
+		slog.Error("error aggregating event facets", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	facets := domain.Facets{}
+	if cursor.Next(context.Background()) {
+		if err := cursor.Decode(&facets); err != nil {
+			slog.Error("error decoding event facets", r.typeAttr(), utils.Err(err))
+			return nil, err
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return &facets, nil
+}
+
+func (r *MongoDBEventRepository[T, PT]) count(filter bson.M) (int, error) {
+	total, err := r.collection.CountDocuments(context.Background(), notDeleted(filter))
+	if err != nil {
+		slog.Error("error counting events", r.typeAttr(), utils.Err(err))
+		return 0, err
+	}
+
+	return int(total), nil
+}
+
+// minTextQueryLength is the shortest query searched through the text index.
+// The index matches whole words only, so shorter queries are still being
+// typed and are matched as name prefixes instead.
+const minTextQueryLength = 3
+
+func isTextQuery(query string) bool {
+	return utf8.RuneCountInString(strings.TrimSpace(query)) >= minTextQueryLength
+}
+
+func searchFilter(kind domain.EventKind, query string) bson.M {
+	if isTextQuery(query) {
+		return bson.M{"$text": bson.M{"$search": query}}
+	}
+
+	return regexFilter(kind, "^"+regexp.QuoteMeta(strings.TrimSpace(query)))
+}
+
+// regexFilter matches pattern case-insensitively against the name-like
+// fields of the kind. Callers escape user input.
+func regexFilter(kind domain.EventKind, pattern string) bson.M {
+	conditions := make([]bson.M, 0, len(kind.SearchFields))
+	for _, field := range kind.SearchFields {
+		conditions = append(conditions, bson.M{field: bson.M{"$regex": pattern, "$options": "i"}})
+	}
+
+	return bson.M{"$or": conditions}
+}
+
+func tagsFilter(kind domain.EventKind, tags []string) bson.M {
+	if !kind.MatchAllTags {
+		return bson.M{"tags": bson.M{"$in": tags}}
+	}
+
+	var tagConditions []bson.M
+	for _, tag := range tags {
+		tagConditions = append(tagConditions, bson.M{"tags": tag})
+	}
+
+	return bson.M{"$and": tagConditions}
+}
+
+func (r *MongoDBEventRepository[T, PT]) Collection() *mongo.Collection {
+	return r.collection
+}
+
+func (r *MongoDBEventRepository[T, PT]) Kind() domain.EventKind {
+	return r.kind
+}
+
+func (r *MongoDBEventRepository[T, PT]) DecodeEvent(raw bson.Raw) (domain.Event, error) {
+	var event T
+	if err := bson.Unmarshal(raw, &event); err != nil {
+		return nil, err
+	}
+
+	return PT(&event), nil
+}
+
+// setSearchNames refreshes the folded names suggestions are matched against.
+func (r *MongoDBEventRepository[T, PT]) setSearchNames(event *T) *domain.EventBase {
+	base := PT(event).Base()
+
+	names := []string{base.Name}
+	if namer, ok := any(PT(event)).(domain.AlternateNamer); ok {
+		names = append(names, namer.AlternateNames()...)
+	}
+
+	base.SearchName = normalize.Name(base.Name)
+	base.SearchKeys = normalize.Keys(names...)
+
+	return base
+}
+
+func (r *MongoDBEventRepository[T, PT]) typeAttr() slog.Attr {
+	return slog.String("eventType", string(r.kind.Type))
+}
+
+// timestamp is the current time at the millisecond precision MongoDB stores, so
+// that returned and stored timestamps agree.
+func timestamp() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// notDeleted narrows filter to events outside the trash, deleted to events
+// in it.
+func notDeleted(filter bson.M) bson.M {
+	return withCondition(filter, bson.M{"deletedAt": bson.M{"$exists": false}})
+}
+
+func deleted(filter bson.M) bson.M {
+	return withCondition(filter, bson.M{"deletedAt": bson.M{"$exists": true}})
+}
+
+// withStatuses narrows filter to events in one of the publication
+// statuses, or to published events when none are given. published narrows
+// it to published events.
+func withStatuses(filter bson.M, statuses []domain.PublicationStatus) bson.M {
+	if len(statuses) == 0 {
+		return published(filter)
+	}
+
+	return withCondition(filter, bson.M{"status": bson.M{"$in": statuses}})
+}
+
+func published(filter bson.M) bson.M {
+	return withCondition(filter, bson.M{"status": domain.StatusPublished})
+}
+
+func withCondition(filter, condition bson.M) bson.M {
+	if len(filter) == 0 {
+		return condition
+	}
+
+	return bson.M{"$and": []bson.M{filter, condition}}
+}
+
+// versionFilter matches the event with the given version, or with any
+// version when it is zero.
+func versionFilter(id primitive.ObjectID, version int) bson.M {
+	filter := bson.M{"_id": id}
+	if version != 0 {
+		filter["version"] = version
+	}
+
+	return filter
+}
+
+// sortDocument turns a sort into a find sort, with _id as the last key so
+// that pages are stable. An empty sort yields fallback.
+func sortDocument(sort domain.Sort, fallback bson.D) bson.D {
+	if len(sort) == 0 {
+		return fallback
+	}
+
+	document := make(bson.D, 0, len(sort)+1)
+	hasID := false
+	for _, key := range sort {
+		direction := 1
+		if key.Descending {
+			direction = -1
+		}
+		document = append(document, bson.E{Key: key.Field, Value: direction})
+		hasID = hasID || key.Field == "_id"
+	}
+
+	if !hasID {
+		document = append(document, bson.E{Key: "_id", Value: 1})
+	}
+
+	return document
+}
+
+// eventFilter translates a filter into a query. Field names and operators
+// are fixed here, the filter only contributes values.
+func eventFilter(kind domain.EventKind, filter domain.EventFilter) (bson.M, error) {
+	conditions := []bson.M{}
+	addList := func(field, operator string, values []string) {
+		if len(values) > 0 {
+			conditions = append(conditions, bson.M{field: bson.M{operator: values}})
+		}
+	}
+
+	addList("tags", "$all", filter.TagsAll)
+	addList("tags", "$in", filter.TagsAny)
+	addList("tags", "$nin", filter.TagsNone)
+	addList("categories", "$all", filter.CategoriesAll)
+	addList("categories", "$in", filter.CategoriesAny)
+	addList("categories", "$nin", filter.CategoriesNone)
+
+	if filter.HasReleaseRange() {
+		if kind.StartDateField == "" {
+			return nil, domain.ErrUnsupportedFilter
+		}
+
+		var from, to time.Time
+		if filter.ReleaseFrom != nil {
+			from = *filter.ReleaseFrom
+		}
+		if filter.ReleaseTo != nil {
+			to = *filter.ReleaseTo
+		}
+		conditions = append(conditions, dateRangeFilter(kind, from, to))
+	}
+
+	if condition := rangeFilter(filter.DurationMin, filter.DurationMax); condition != nil {
+		conditions = append(conditions, bson.M{"duration": condition})
+	}
+	if condition := rangeFilter(filter.AgeMin, filter.AgeMax); condition != nil {
+		conditions = append(conditions, bson.M{"age": condition})
+	}
+
+	if filter.HasMedia != nil {
+		conditions = append(conditions, bson.M{"media.0": bson.M{"$exists": *filter.HasMedia}})
+	}
+
+	if len(conditions) == 0 {
+		return bson.M{}, nil
+	}
+
+	return bson.M{"$and": conditions}, nil
+}
+
+// rangeFilter returns the condition for an inclusive numeric range, nil when
+// both bounds are open. Events without a value never match a range.
+func rangeFilter(min, max *int) bson.M {
+	condition := bson.M{}
+	if min != nil {
+		condition["$gte"] = *min
+	}
+	if max != nil {
+		condition["$lte"] = *max
+	}
+
+	if len(condition) == 0 {
+		return nil
+	}
+
+	return condition
+}
+
+// dateRangeFilter matches events of a dated kind whose own [start, end]
+// dates overlap [from, to). A zero bound leaves that side open.
+func dateRangeFilter(kind domain.EventKind, from, to time.Time) bson.M {
+	condition := bson.M{}
+	addCondition := func(field, operator string, value interface{}) {
+		fieldCondition, ok := condition[field].(bson.M)
+		if !ok {
+			fieldCondition = bson.M{}
+			condition[field] = fieldCondition
+		}
+		fieldCondition[operator] = value
+	}
+
+	if !to.IsZero() {
+		addCondition(kind.StartDateField, "$lt", to)
+	}
+	if !from.IsZero() {
+		addCondition(kind.EndDateField, "$gte", from)
+	}
+
+	return condition
+}
+
+// maxFacetValues caps each facet, a sidebar has no use for the long tail.
+const maxFacetValues = 50
+
+func facetStages(kind domain.EventKind) bson.M {
+	countBy := func(field string, unwind bool) bson.A {
+		stages := bson.A{}
+		if unwind {
+			stages = append(stages, bson.M{"$unwind": "$" + field})
+		}
+		return append(stages,
+			bson.M{"$match": bson.M{field: bson.M{"$nin": bson.A{nil, ""}}}},
+			bson.M{"$group": bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+			bson.M{"$limit": maxFacetValues},
+		)
+	}
+
+	stages := bson.M{
+		"categories": countBy("categories", true),
+		"tags":       countBy("tags", true),
+		"ages": bson.A{
+			bson.M{"$match": bson.M{"age": bson.M{"$type": "number"}}},
+			bson.M{"$group": bson.M{"_id": "$age", "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.M{"_id": 1}},
+		},
+	}
+
+	if kind.StartDateField != "" {
+		field := kind.StartDateField
+		stages["years"] = bson.A{
+			bson.M{"$match": bson.M{field: bson.M{"$type": "date"}}},
+			bson.M{"$group": bson.M{"_id": bson.M{"$year": "$" + field}, "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.M{"_id": -1}},
+		}
+	}
+
+	return stages
+}
+
+// toDocument encodes v with its bson tags into a map that can be used as
+// the $set of an update.
+func toDocument(v interface{}) (bson.M, error) {
+	data, err := bson.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var document bson.M
+	if err := bson.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
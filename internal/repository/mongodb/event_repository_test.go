@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"events/internal/domain"
+)
+
+func TestPurge(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	purged := primitive.NewObjectID()
+	restored := primitive.NewObjectID()
+
+	deleted := func(n int) bson.D {
+		return mtest.CreateSuccessResponse(bson.E{Key: "n", Value: n})
+	}
+
+	mt.Run("Event restored during the purge is kept", func(mt *mtest.T) {
+		repo := NewMongoDBEventRepository[domain.Movie](mt.Coll)
+		mt.AddMockResponses(
+			mtest.CreateCursorResponse(0, "test.movies", mtest.FirstBatch,
+				bson.D{{Key: "_id", Value: purged}},
+				bson.D{{Key: "_id", Value: restored}},
+			),
+			deleted(1),
+			// The restore cleared deletedAt after the lookup, so the second
+			// deletion no longer matches.
+			deleted(0),
+		)
+
+		ids, err := repo.Purge(time.Now())
+
+		assert.NoError(mt, err)
+		assert.Equal(mt, []primitive.ObjectID{purged}, ids)
+	})
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"events/internal/domain"
+)
+
+func TestFacetStages(t *testing.T) {
+	tests := []struct {
+		name      string
+		kind      domain.EventKind
+		wantYears bool
+	}{
+		{name: "Dated kind counts years", kind: (*domain.Movie)(nil).Kind(), wantYears: true},
+		{name: "Undated kind has no years", kind: (*domain.Performance)(nil).Kind(), wantYears: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stages := facetStages(tt.kind)
+
+			assert.Contains(t, stages, "categories")
+			assert.Contains(t, stages, "tags")
+			assert.Contains(t, stages, "ages")
+			_, hasYears := stages["years"]
+			assert.Equal(t, tt.wantYears, hasYears)
+		})
+	}
+}
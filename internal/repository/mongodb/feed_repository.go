@@ -0,0 +1,217 @@
+package repository
+
+import (
+	"context"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// FeedSource is an event collection the feed reads from. Every
+// MongoDBEventRepository is one.
+type FeedSource interface {
+	Collection() *mongo.Collection
+	Kind() domain.EventKind
+	DecodeEvent(raw bson.Raw) (domain.Event, error)
+}
+
+// MongoDBFeedRepository reads all registered event collections in a single
+// aggregation: the first collection is the base of the pipeline and the
+// others are appended with $unionWith, then the union is sorted and paged.
+// All collections must live in the same database.
+type MongoDBFeedRepository struct {
+	showtimes *mongo.Collection
+	sources   []FeedSource
+}
+
+func NewMongoDBFeedRepository(showtimes *mongo.Collection) *MongoDBFeedRepository {
+	return &MongoDBFeedRepository{
+		showtimes: showtimes,
+	}
+}
+
+func (r *MongoDBFeedRepository) AddSource(source FeedSource) {
+	r.sources = append(r.sources, source)
+}
+
+func (r *MongoDBFeedRepository) GetFeed(filter domain.FeedFilter, sort domain.Sort, page, pageSize int) ([]*domain.FeedItem, int, error) {
+	sources, err := r.selectSources(filter.Types)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(sources) == 0 {
+		return []*domain.FeedItem{}, 0, nil
+	}
+
+	pipeline := r.sourcePipeline(sources[0].Kind(), filter)
+	for _, source := range sources[1:] {
+		pipeline = append(pipeline, bson.D{{Key: "$unionWith", Value: bson.M{
+			"coll":     source.Collection().Name(),
+			"pipeline": r.sourcePipeline(source.Kind(), filter),
+		}}})
+	}
+
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: sortDocument(sort, bson.D{{Key: "_id", Value: -1}})}},
+		bson.D{{Key: "$facet", Value: bson.M{
+			"items": bson.A{
+				bson.M{"$skip": (page - 1) * pageSize},
+				bson.M{"$limit": pageSize},
+			},
+			"total": bson.A{bson.M{"$count": "count"}},
+		}}},
+	)
+
+	cursor, err := sources[0].Collection().Aggregate(context.Background(), pipeline)
+	if err != nil {
+		slog.Error("error aggregating event feed", utils.Err(err))
+		return nil, 0, err
+	}
+	defer cursor.Close(context.Background())
+
+	var result []struct {
+		Items []bson.Raw `bson:"items"`
+		Total []struct {
+			Count int `bson:"count"`
+		} `bson:"total"`
+	}
+	if err := cursor.All(context.Background(), &result); err != nil {
+		slog.Error("error decoding event feed", utils.Err(err))
+		return nil, 0, err
+	}
+
+	items := []*domain.FeedItem{}
+	total := 0
+	if len(result) > 0 {
+		if len(result[0].Total) > 0 {
+			total = result[0].Total[0].Count
+		}
+
+		for _, raw := range result[0].Items {
+			item, err := decodeFeedItem(sources, raw)
+			if err != nil {
+				slog.Error("error decoding feed item", utils.Err(err))
+				return nil, 0, err
+			}
+			items = append(items, item)
+		}
+	}
+
+	return items, total, nil
+}
+
+// sourcePipeline returns the stages applied to one event collection before
+// it joins the union. Only published events outside the trash are included.
+// Date ranges match events that have a showtime in the range or, for dated
+// kinds, whose own dates overlap it.
+func (r *MongoDBFeedRepository) sourcePipeline(kind domain.EventKind, filter domain.FeedFilter) mongo.Pipeline {
+	conditions := []bson.M{
+		{"deletedAt": bson.M{"$exists": false}},
+		{"status": domain.StatusPublished},
+	}
+	if len(filter.Categories) > 0 {
+		conditions = append(conditions, bson.M{"categories": bson.M{"$in": filter.Categories}})
+	}
+	if len(filter.Tags) > 0 {
+		conditions = append(conditions, tagsFilter(kind, filter.Tags))
+	}
+	if filter.Query != "" {
+		// Text scores of different collections can't be compared, so the
+		// feed matches the escaped query anywhere in the name-like fields.
+		conditions = append(conditions, regexFilter(kind, regexp.QuoteMeta(filter.Query)))
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"$and": conditions}}},
+	}
+
+	if filter.HasDateRange() {
+		startTime := bson.M{}
+		if !filter.From.IsZero() {
+			startTime["$gte"] = filter.From
+		}
+		if !filter.To.IsZero() {
+			startTime["$lt"] = filter.To
+		}
+
+		pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.M{
+			"from":         r.showtimes.Name(),
+			"localField":   "_id",
+			"foreignField": "eventId",
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"eventType": kind.Type, "startTime": startTime}},
+				bson.M{"$limit": 1},
+				bson.M{"$project": bson.M{"_id": 1}},
+			},
+			"as": "feedShowtimes",
+		}}})
+
+		dateConditions := []bson.M{{"feedShowtimes.0": bson.M{"$exists": true}}}
+		if kind.StartDateField != "" {
+			dateConditions = append(dateConditions, dateRangeFilter(kind, filter.From, filter.To))
+		}
+
+		pipeline = append(pipeline,
+			bson.D{{Key: "$match", Value: bson.M{"$or": dateConditions}}},
+			bson.D{{Key: "$project", Value: bson.M{"feedShowtimes": 0}}},
+		)
+	}
+
+	return append(pipeline, bson.D{{Key: "$addFields", Value: bson.M{"type": kind.Type}}})
+}
+
+func (r *MongoDBFeedRepository) selectSources(types []domain.EventType) ([]FeedSource, error) {
+	if len(types) == 0 {
+		return r.sources, nil
+	}
+
+	// Each collection joins the union once, however often its type is given.
+	selected := make(map[domain.EventType]bool, len(types))
+
+	sources := make([]FeedSource, 0, len(types))
+	for _, eventType := range types {
+		if selected[eventType] {
+			continue
+		}
+		selected[eventType] = true
+
+		source := findSource(r.sources, eventType)
+		if source == nil {
+			return nil, domain.ErrInvalidEventType
+		}
+		sources = append(sources, source)
+	}
+
+	return sources, nil
+}
+
+func decodeFeedItem(sources []FeedSource, raw bson.Raw) (*domain.FeedItem, error) {
+	value, _ := raw.Lookup("type").StringValueOK()
+	eventType := domain.EventType(value)
+
+	source := findSource(sources, eventType)
+	if source == nil {
+		return nil, domain.ErrInvalidEventType
+	}
+
+	event, err := source.DecodeEvent(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.FeedItem{Type: eventType, Event: event}, nil
+}
+
+func findSource(sources []FeedSource, eventType domain.EventType) FeedSource {
+	for _, source := range sources {
+		if source.Kind().Type == eventType {
+			return source
+		}
+	}
+
+	return nil
+}
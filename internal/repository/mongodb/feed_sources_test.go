@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"events/internal/domain"
+)
+
+type feedSourceStub struct {
+	kind       domain.EventKind
+	collection *mongo.Collection
+}
+
+func (s feedSourceStub) Collection() *mongo.Collection { return s.collection }
+func (s feedSourceStub) Kind() domain.EventKind        { return s.kind }
+func (s feedSourceStub) DecodeEvent(bson.Raw) (domain.Event, error) {
+	return nil, nil
+}
+
+func TestSelectSources(t *testing.T) {
+	movies := feedSourceStub{kind: (*domain.Movie)(nil).Kind()}
+	performances := feedSourceStub{kind: (*domain.Performance)(nil).Kind()}
+
+	repo := NewMongoDBFeedRepository(nil)
+	repo.AddSource(movies)
+	repo.AddSource(performances)
+
+	tests := []struct {
+		name    string
+		types   []domain.EventType
+		want    []FeedSource
+		wantErr error
+	}{
+		{
+			name:  "No types selects every source",
+			types: nil,
+			want:  []FeedSource{movies, performances},
+		},
+		{
+			name:  "Sources in the order of the types",
+			types: []domain.EventType{domain.EventTypePerformance, domain.EventTypeMovie},
+			want:  []FeedSource{performances, movies},
+		},
+		{
+			name:  "Repeated type joins the union once",
+			types: []domain.EventType{domain.EventTypeMovie, domain.EventTypeMovie},
+			want:  []FeedSource{movies},
+		},
+		{
+			name:    "Unknown type",
+			types:   []domain.EventType{"circus"},
+			wantErr: domain.ErrInvalidEventType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.selectSources(tt.types)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoDBHallRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDBHallRepository(collection *mongo.Collection) *MongoDBHallRepository {
+	return &MongoDBHallRepository{
+		collection: collection,
+	}
+}
+
+func (r *MongoDBHallRepository) EnsureIndexes() error {
+	index := mongo.IndexModel{Keys: bson.D{{Key: "venueId", Value: 1}, {Key: "name", Value: 1}}}
+
+	if _, err := r.collection.Indexes().CreateOne(context.Background(), index); err != nil {
+		slog.Error("error creating hall indexes", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBHallRepository) GetHallsByVenue(venueID primitive.ObjectID) ([]*domain.GetHallResponse, error) {
+	filter := bson.M{"venueId": venueID}
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+
+	cursor, err := r.collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		slog.Error("error retrieving hall list", utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	var halls []*domain.GetHallResponse
+	for cursor.Next(context.Background()) {
+		var hall domain.GetHallResponse
+		if err := cursor.Decode(&hall); err != nil {
+			slog.Error("error decoding hall", utils.Err(err))
+			return nil, err
+		}
+		halls = append(halls, &hall)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return halls, nil
+}
+
+func (r *MongoDBHallRepository) GetHallByID(id primitive.ObjectID) (*domain.GetHallResponse, error) {
+	filter := bson.M{"_id": id}
+
+	var hall domain.GetHallResponse
+
+	err := r.collection.FindOne(context.Background(), filter).Decode(&hall)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		slog.Error("error getting hall by ID", utils.Err(err))
+		return nil, err
+	}
+
+	return &hall, nil
+}
+
+func (r *MongoDBHallRepository) CreateHall(venueID primitive.ObjectID, hall *domain.CreateHallRequest) (*domain.CreateHallResponse, error) {
+	h := domain.CreateHallResponse{
+		VenueID:        venueID,
+		Name:           hall.Name,
+		Capacity:       hall.SeatMap.Capacity(),
+		SeatCategories: hall.SeatCategories,
+		SeatMap:        hall.SeatMap,
+	}
+
+	result, err := r.collection.InsertOne(context.Background(), h)
+	if err != nil {
+		slog.Error("error inserting hall document", utils.Err(err))
+		return nil, err
+	}
+
+	insertedID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		slog.Error("error getting inserted hall ID")
+		return nil, errors.New("error getting inserted hall ID")
+	}
+
+	h.ID = insertedID
+
+	return &h, nil
+}
+
+func (r *MongoDBHallRepository) UpdateHall(id primitive.ObjectID, update *domain.UpdateHallRequest) (*domain.UpdateHallResponse, error) {
+	updateFields := bson.M{
+		"$set": bson.M{
+			"name":           update.Name,
+			"capacity":       update.SeatMap.Capacity(),
+			"seatCategories": update.SeatCategories,
+			"seatMap":        update.SeatMap,
+		},
+	}
+
+	filter := bson.M{"_id": id}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var hall domain.UpdateHallResponse
+
+	err := r.collection.FindOneAndUpdate(context.Background(), filter, updateFields, opts).Decode(&hall)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrHallNotFound
+		}
+		slog.Error("error updating hall", utils.Err(err))
+		return nil, err
+	}
+
+	return &hall, nil
+}
+
+func (r *MongoDBHallRepository) DeleteHall(id primitive.ObjectID) error {
+	filter := bson.M{"_id": id}
+
+	result, err := r.collection.DeleteOne(context.Background(), filter)
+	if err != nil {
+		slog.Error("error deleting hall", utils.Err(err))
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrHallNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoDBHallRepository) DeleteHallsByVenue(venueID primitive.ObjectID) error {
+	filter := bson.M{"venueId": venueID}
+
+	if _, err := r.collection.DeleteMany(context.Background(), filter); err != nil {
+		slog.Error("error deleting venue halls", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
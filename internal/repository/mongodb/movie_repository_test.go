@@ -14,6 +14,8 @@ import (
 	mock_repository "events/internal/repository/mocks"
 )
 
+var adultsOnly = domain.AgeRating(18)
+
 func TestGetAllMovies(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -24,7 +26,7 @@ func TestGetAllMovies(t *testing.T) {
 		name     string
 		page     int
 		pageSize int
-		want     []*domain.GetMovieResponse
+		want     []*domain.Movie
 		wantErr  bool
 		err      error
 	}{
@@ -32,32 +34,36 @@ func TestGetAllMovies(t *testing.T) {
 			name:     "Successful retrieval",
 			page:     1,
 			pageSize: 10,
-			want: []*domain.GetMovieResponse{
+			want: []*domain.Movie{
 				{
-					ID:           primitive.NewObjectID(),
-					Cover:        "cover1.jpg",
-					Name:         "Test Movie 1",
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover1.jpg",
+						Name:        "Test Movie 1",
+						Description: "This is a test movie 1",
+						Duration:    120,
+						Age:         &adultsOnly,
+						Categories:  []string{"Action", "Adventure"},
+						Tags:        []string{"test", "movie"},
+						Media:       []string{"media1", "media2"},
+					},
 					OriginalName: "Test Movie Original 1",
-					Description:  "This is a test movie 1",
-					Duration:     "120 mins",
 					ReleaseDate:  time.Now(),
-					Age:          "18+",
-					Categories:   []string{"Action", "Adventure"},
-					Tags:         []string{"test", "movie"},
-					Media:        []string{"media1", "media2"},
 				},
 				{
-					ID:           primitive.NewObjectID(),
-					Cover:        "cover2.jpg",
-					Name:         "Test Movie 2",
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover2.jpg",
+						Name:        "Test Movie 2",
+						Description: "This is a test movie 2",
+						Duration:    150,
+						Age:         &adultsOnly,
+						Categories:  []string{"Comedy", "Drama"},
+						Tags:        []string{"test", "movie"},
+						Media:       []string{"media3", "media4"},
+					},
 					OriginalName: "Test Movie Original 2",
-					Description:  "This is a test movie 2",
-					Duration:     "150 mins",
 					ReleaseDate:  time.Now(),
-					Age:          "18+",
-					Categories:   []string{"Comedy", "Drama"},
-					Tags:         []string{"test", "movie"},
-					Media:        []string{"media3", "media4"},
 				},
 			},
 			wantErr: false,
@@ -83,15 +89,15 @@ func TestGetAllMovies(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().GetAllMovies(tt.page, tt.pageSize).Return(tt.want, tt.err)
+			mockRepo.EXPECT().GetAll(domain.EventFilter{}, nil, tt.page, tt.pageSize).Return(tt.want, tt.err)
 
-			got, err := mockRepo.GetAllMovies(tt.page, tt.pageSize)
+			got, err := mockRepo.GetAll(domain.EventFilter{}, nil, tt.page, tt.pageSize)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GetAllMovies() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GetAll() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GetAllMovies() got = %v, want %v", got, tt.want)
+				t.Errorf("GetAll() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -104,23 +110,25 @@ func TestGetMovieByID(t *testing.T) {
 	mockRepo := mock_repository.NewMockMovieRepository(ctrl)
 
 	id := primitive.NewObjectID()
-	expectedMovie := &domain.GetMovieResponse{
-		ID:           id,
-		Cover:        "cover",
-		Name:         "name",
+	expectedMovie := &domain.Movie{
+		EventBase: domain.EventBase{
+			ID:          id,
+			Cover:       "cover",
+			Name:        "name",
+			Description: "description",
+			Duration:    95,
+			Age:         &adultsOnly,
+			Categories:  []string{"category1", "category2"},
+			Tags:        []string{"tag1", "tag2"},
+			Media:       []string{"media1", "media2"},
+		},
 		OriginalName: "originalName",
-		Description:  "description",
-		Duration:     "duration",
 		ReleaseDate:  time.Now(),
-		Age:          "age",
-		Categories:   []string{"category1", "category2"},
-		Tags:         []string{"tag1", "tag2"},
-		Media:        []string{"media1", "media2"},
 	}
 
 	testCases := []struct {
 		name          string
-		expectedMovie *domain.GetMovieResponse
+		expectedMovie *domain.Movie
 		expectedErr   error
 	}{
 		{
@@ -137,9 +145,9 @@ func TestGetMovieByID(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			mockRepo.EXPECT().GetMovieByID(id).Return(tc.expectedMovie, tc.expectedErr).Times(1)
+			mockRepo.EXPECT().GetByID(id).Return(tc.expectedMovie, tc.expectedErr).Times(1)
 
-			movie, err := mockRepo.GetMovieByID(id)
+			movie, err := mockRepo.GetByID(id)
 
 			if tc.expectedErr != nil {
 				assert.Error(t, err)
@@ -159,54 +167,60 @@ func TestCreateMovie(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		request *domain.CreateMovieRequest
-		want    *domain.CreateMovieResponse
+		request *domain.Movie
+		want    *domain.Movie
 		wantErr bool
 		err     error
 	}{
 		{
 			name: "Successful creation",
-			request: &domain.CreateMovieRequest{
-				Cover:        "cover.jpg",
-				Name:         "Test Movie",
+			request: &domain.Movie{
+				EventBase: domain.EventBase{
+					Cover:       "cover.jpg",
+					Name:        "Test Movie",
+					Description: "This is a test movie",
+					Duration:    120,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"test", "movie"},
+					Media:       []string{"media1", "media2"},
+				},
 				OriginalName: "Test Movie Original",
-				Description:  "This is a test movie",
-				Duration:     "120 mins",
 				ReleaseDate:  time.Now(),
-				Age:          "18+",
-				Categories:   []string{"Action", "Adventure"},
-				Tags:         []string{"test", "movie"},
-				Media:        []string{"media1", "media2"},
 			},
-			want: &domain.CreateMovieResponse{
-				ID:           primitive.NewObjectID(),
-				Cover:        "cover.jpg",
-				Name:         "Test Movie",
+			want: &domain.Movie{
+				EventBase: domain.EventBase{
+					ID:          primitive.NewObjectID(),
+					Cover:       "cover.jpg",
+					Name:        "Test Movie",
+					Description: "This is a test movie",
+					Duration:    120,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"test", "movie"},
+					Media:       []string{"media1", "media2"},
+				},
 				OriginalName: "Test Movie Original",
-				Description:  "This is a test movie",
-				Duration:     "120 mins",
 				ReleaseDate:  time.Now(),
-				Age:          "18+",
-				Categories:   []string{"Action", "Adventure"},
-				Tags:         []string{"test", "movie"},
-				Media:        []string{"media1", "media2"},
 			},
 			wantErr: false,
 			err:     nil,
 		},
 		{
 			name: "Error inserting movie document",
-			request: &domain.CreateMovieRequest{
-				Cover:        "cover.jpg",
-				Name:         "Test Movie",
+			request: &domain.Movie{
+				EventBase: domain.EventBase{
+					Cover:       "cover.jpg",
+					Name:        "Test Movie",
+					Description: "This is a test movie",
+					Duration:    120,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"test", "movie"},
+					Media:       []string{"media1", "media2"},
+				},
 				OriginalName: "Test Movie Original",
-				Description:  "This is a test movie",
-				Duration:     "120 mins",
 				ReleaseDate:  time.Now(),
-				Age:          "18+",
-				Categories:   []string{"Action", "Adventure"},
-				Tags:         []string{"test", "movie"},
-				Media:        []string{"media1", "media2"},
 			},
 			want:    nil,
 			wantErr: true,
@@ -214,17 +228,19 @@ func TestCreateMovie(t *testing.T) {
 		},
 		{
 			name: "Error getting inserted movie ID",
-			request: &domain.CreateMovieRequest{
-				Cover:        "cover.jpg",
-				Name:         "Test Movie",
+			request: &domain.Movie{
+				EventBase: domain.EventBase{
+					Cover:       "cover.jpg",
+					Name:        "Test Movie",
+					Description: "This is a test movie",
+					Duration:    120,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"test", "movie"},
+					Media:       []string{"media1", "media2"},
+				},
 				OriginalName: "Test Movie Original",
-				Description:  "This is a test movie",
-				Duration:     "120 mins",
 				ReleaseDate:  time.Now(),
-				Age:          "18+",
-				Categories:   []string{"Action", "Adventure"},
-				Tags:         []string{"test", "movie"},
-				Media:        []string{"media1", "media2"},
 			},
 			want:    nil,
 			wantErr: true,
@@ -234,15 +250,15 @@ func TestCreateMovie(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().CreateMovie(tt.request).Return(tt.want, tt.err)
+			mockRepo.EXPECT().Create(tt.request).Return(tt.want, tt.err)
 
-			got, err := mockRepo.CreateMovie(tt.request)
+			got, err := mockRepo.Create(tt.request)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("CreateMovie() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("CreateMovie() got = %v, want %v", got, tt.want)
+				t.Errorf("Create() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -257,38 +273,42 @@ func TestUpdateMovie(t *testing.T) {
 	tests := []struct {
 		name    string
 		id      primitive.ObjectID
-		request *domain.UpdateMovieRequest
-		want    *domain.UpdateMovieResponse
+		request *domain.Movie
+		want    *domain.Movie
 		wantErr bool
 		err     error
 	}{
 		{
 			name: "Successful update",
 			id:   primitive.NewObjectID(),
-			request: &domain.UpdateMovieRequest{
-				Cover:        "new_cover.jpg",
-				Name:         "New Test Movie",
+			request: &domain.Movie{
+				EventBase: domain.EventBase{
+					Cover:       "new_cover.jpg",
+					Name:        "New Test Movie",
+					Description: "This is a new test movie",
+					Duration:    150,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"new_test", "movie"},
+					Media:       []string{"new_media1", "new_media2"},
+				},
 				OriginalName: "New Test Movie Original",
-				Description:  "This is a new test movie",
-				Duration:     "150 mins",
 				ReleaseDate:  time.Now(),
-				Age:          "18+",
-				Categories:   []string{"Action", "Adventure"},
-				Tags:         []string{"new_test", "movie"},
-				Media:        []string{"new_media1", "new_media2"},
 			},
-			want: &domain.UpdateMovieResponse{
-				ID:           primitive.NewObjectID(),
-				Cover:        "new_cover.jpg",
-				Name:         "New Test Movie",
+			want: &domain.Movie{
+				EventBase: domain.EventBase{
+					ID:          primitive.NewObjectID(),
+					Cover:       "new_cover.jpg",
+					Name:        "New Test Movie",
+					Description: "This is a new test movie",
+					Duration:    150,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"new_test", "movie"},
+					Media:       []string{"new_media1", "new_media2"},
+				},
 				OriginalName: "New Test Movie Original",
-				Description:  "This is a new test movie",
-				Duration:     "150 mins",
 				ReleaseDate:  time.Now(),
-				Age:          "18+",
-				Categories:   []string{"Action", "Adventure"},
-				Tags:         []string{"new_test", "movie"},
-				Media:        []string{"new_media1", "new_media2"},
 			},
 			wantErr: false,
 			err:     nil,
@@ -296,17 +316,19 @@ func TestUpdateMovie(t *testing.T) {
 		{
 			name: "Error updating movie",
 			id:   primitive.NewObjectID(),
-			request: &domain.UpdateMovieRequest{
-				Cover:        "new_cover.jpg",
-				Name:         "New Test Movie",
+			request: &domain.Movie{
+				EventBase: domain.EventBase{
+					Cover:       "new_cover.jpg",
+					Name:        "New Test Movie",
+					Description: "This is a new test movie",
+					Duration:    150,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"new_test", "movie"},
+					Media:       []string{"new_media1", "new_media2"},
+				},
 				OriginalName: "New Test Movie Original",
-				Description:  "This is a new test movie",
-				Duration:     "150 mins",
 				ReleaseDate:  time.Now(),
-				Age:          "18+",
-				Categories:   []string{"Action", "Adventure"},
-				Tags:         []string{"new_test", "movie"},
-				Media:        []string{"new_media1", "new_media2"},
 			},
 			want:    nil,
 			wantErr: true,
@@ -315,17 +337,19 @@ func TestUpdateMovie(t *testing.T) {
 		{
 			name: "Error fetching updated movie",
 			id:   primitive.NewObjectID(),
-			request: &domain.UpdateMovieRequest{
-				Cover:        "new_cover.jpg",
-				Name:         "New Test Movie",
+			request: &domain.Movie{
+				EventBase: domain.EventBase{
+					Cover:       "new_cover.jpg",
+					Name:        "New Test Movie",
+					Description: "This is a new test movie",
+					Duration:    150,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"new_test", "movie"},
+					Media:       []string{"new_media1", "new_media2"},
+				},
 				OriginalName: "New Test Movie Original",
-				Description:  "This is a new test movie",
-				Duration:     "150 mins",
 				ReleaseDate:  time.Now(),
-				Age:          "18+",
-				Categories:   []string{"Action", "Adventure"},
-				Tags:         []string{"new_test", "movie"},
-				Media:        []string{"new_media1", "new_media2"},
 			},
 			want:    nil,
 			wantErr: true,
@@ -335,15 +359,15 @@ func TestUpdateMovie(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().UpdateMovie(tt.id, tt.request).Return(tt.want, tt.err)
+			mockRepo.EXPECT().Update(tt.id, tt.request, 0).Return(tt.want, tt.err)
 
-			got, err := mockRepo.UpdateMovie(tt.id, tt.request)
+			got, err := mockRepo.Update(tt.id, tt.request, 0)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("UpdateMovie() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("UpdateMovie() got = %v, want %v", got, tt.want)
+				t.Errorf("Update() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -373,9 +397,9 @@ func TestDeleteMovie(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			mockRepo.EXPECT().DeleteMovie(id).Return(tc.expectedErr).Times(1)
+			mockRepo.EXPECT().Delete(id, 0, domain.AnonymousActor).Return(tc.expectedErr).Times(1)
 
-			err := mockRepo.DeleteMovie(id)
+			err := mockRepo.Delete(id, 0, domain.AnonymousActor)
 
 			if tc.expectedErr != nil {
 				assert.Error(t, err)
@@ -398,7 +422,7 @@ func TestSearchMovies(t *testing.T) {
 		query    string
 		page     int
 		pageSize int
-		want     []*domain.GetMovieResponse
+		want     []*domain.Movie
 		wantErr  bool
 		err      error
 	}{
@@ -407,32 +431,36 @@ func TestSearchMovies(t *testing.T) {
 			query:    "Test Movie",
 			page:     1,
 			pageSize: 10,
-			want: []*domain.GetMovieResponse{
+			want: []*domain.Movie{
 				{
-					ID:           primitive.NewObjectID(),
-					Cover:        "cover1.jpg",
-					Name:         "Test Movie 1",
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover1.jpg",
+						Name:        "Test Movie 1",
+						Description: "This is a test movie 1",
+						Duration:    120,
+						Age:         &adultsOnly,
+						Categories:  []string{"Action", "Adventure"},
+						Tags:        []string{"test", "movie"},
+						Media:       []string{"media1", "media2"},
+					},
 					OriginalName: "Test Movie Original 1",
-					Description:  "This is a test movie 1",
-					Duration:     "120 mins",
 					ReleaseDate:  time.Now(),
-					Age:          "18+",
-					Categories:   []string{"Action", "Adventure"},
-					Tags:         []string{"test", "movie"},
-					Media:        []string{"media1", "media2"},
 				},
 				{
-					ID:           primitive.NewObjectID(),
-					Cover:        "cover2.jpg",
-					Name:         "Test Movie 2",
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover2.jpg",
+						Name:        "Test Movie 2",
+						Description: "This is a test movie 2",
+						Duration:    150,
+						Age:         &adultsOnly,
+						Categories:  []string{"Comedy", "Drama"},
+						Tags:        []string{"test", "movie"},
+						Media:       []string{"media3", "media4"},
+					},
 					OriginalName: "Test Movie Original 2",
-					Description:  "This is a test movie 2",
-					Duration:     "150 mins",
 					ReleaseDate:  time.Now(),
-					Age:          "18+",
-					Categories:   []string{"Comedy", "Drama"},
-					Tags:         []string{"test", "movie"},
-					Media:        []string{"media3", "media4"},
 				},
 			},
 			wantErr: false,
@@ -460,15 +488,15 @@ func TestSearchMovies(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().SearchMovies(tt.query, tt.page, tt.pageSize).Return(tt.want, tt.err)
+			mockRepo.EXPECT().Search(tt.query, nil, tt.page, tt.pageSize).Return(tt.want, tt.err)
 
-			got, err := mockRepo.SearchMovies(tt.query, tt.page, tt.pageSize)
+			got, err := mockRepo.Search(tt.query, nil, tt.page, tt.pageSize)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("SearchMovies() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Search() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("SearchMovies() got = %v, want %v", got, tt.want)
+				t.Errorf("Search() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -485,7 +513,7 @@ func TestFilterMoviesByTags(t *testing.T) {
 		tags     []string
 		page     int
 		pageSize int
-		want     []*domain.GetMovieResponse
+		want     []*domain.Movie
 		wantErr  bool
 		err      error
 	}{
@@ -494,32 +522,36 @@ func TestFilterMoviesByTags(t *testing.T) {
 			tags:     []string{"Action", "Adventure"},
 			page:     1,
 			pageSize: 10,
-			want: []*domain.GetMovieResponse{
+			want: []*domain.Movie{
 				{
-					ID:           primitive.NewObjectID(),
-					Cover:        "cover1.jpg",
-					Name:         "Test Movie 1",
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover1.jpg",
+						Name:        "Test Movie 1",
+						Description: "This is a test movie 1",
+						Duration:    120,
+						Age:         &adultsOnly,
+						Categories:  []string{"Action", "Adventure"},
+						Tags:        []string{"test", "movie"},
+						Media:       []string{"media1", "media2"},
+					},
 					OriginalName: "Test Movie Original 1",
-					Description:  "This is a test movie 1",
-					Duration:     "120 mins",
 					ReleaseDate:  time.Now(),
-					Age:          "18+",
-					Categories:   []string{"Action", "Adventure"},
-					Tags:         []string{"test", "movie"},
-					Media:        []string{"media1", "media2"},
 				},
 				{
-					ID:           primitive.NewObjectID(),
-					Cover:        "cover2.jpg",
-					Name:         "Test Movie 2",
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover2.jpg",
+						Name:        "Test Movie 2",
+						Description: "This is a test movie 2",
+						Duration:    150,
+						Age:         &adultsOnly,
+						Categories:  []string{"Comedy", "Drama"},
+						Tags:        []string{"test", "movie"},
+						Media:       []string{"media3", "media4"},
+					},
 					OriginalName: "Test Movie Original 2",
-					Description:  "This is a test movie 2",
-					Duration:     "150 mins",
 					ReleaseDate:  time.Now(),
-					Age:          "18+",
-					Categories:   []string{"Comedy", "Drama"},
-					Tags:         []string{"test", "movie"},
-					Media:        []string{"media3", "media4"},
 				},
 			},
 			wantErr: false,
@@ -547,15 +579,15 @@ func TestFilterMoviesByTags(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().FilterMoviesByTags(tt.tags, tt.page, tt.pageSize).Return(tt.want, tt.err)
+			mockRepo.EXPECT().FilterByTags(tt.tags, nil, tt.page, tt.pageSize).Return(tt.want, tt.err)
 
-			got, err := mockRepo.FilterMoviesByTags(tt.tags, tt.page, tt.pageSize)
+			got, err := mockRepo.FilterByTags(tt.tags, nil, tt.page, tt.pageSize)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("FilterMoviesByTags() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("FilterByTags() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("FilterMoviesByTags() got = %v, want %v", got, tt.want)
+				t.Errorf("FilterByTags() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
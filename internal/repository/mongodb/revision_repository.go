@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBRevisionRepository stores the revisions of one event kind. All
+// kinds share a collection and are told apart by entityType.
+type MongoDBRevisionRepository[T any, PT domain.EventPtr[T]] struct {
+	collection *mongo.Collection
+	eventType  domain.EventType
+}
+
+// revisionDocument wraps a stored event with the fields revisions are
+// looked up and listed by.
+type revisionDocument[T any] struct {
+	EntityType domain.EventType   `bson:"entityType"`
+	EntityID   primitive.ObjectID `bson:"entityId"`
+	Number     int                `bson:"revision"`
+	UpdatedAt  time.Time          `bson:"updatedAt"`
+	UpdatedBy  string             `bson:"updatedBy"`
+	Event      *T                 `bson:"event"`
+}
+
+func NewMongoDBRevisionRepository[T any, PT domain.EventPtr[T]](collection *mongo.Collection) *MongoDBRevisionRepository[T, PT] {
+	return &MongoDBRevisionRepository[T, PT]{
+		collection: collection,
+		eventType:  PT(new(T)).Kind().Type,
+	}
+}
+
+func (r *MongoDBRevisionRepository[T, PT]) EnsureIndexes() error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "entityType", Value: 1}, {Key: "entityId", Value: 1}, {Key: "revision", Value: -1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(context.Background(), indexes); err != nil {
+		slog.Error("error creating revision indexes", r.typeAttr(), utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// Save stores the revision unless it is stored already. The content of a
+// version never changes, so saving it again is a no-op.
+func (r *MongoDBRevisionRepository[T, PT]) Save(event *T) error {
+	base := PT(event).Base()
+	revision := revisionDocument[T]{
+		EntityType: r.eventType,
+		EntityID:   base.ID,
+		Number:     base.Version,
+		UpdatedAt:  base.UpdatedAt,
+		UpdatedBy:  base.UpdatedBy,
+		Event:      event,
+	}
+
+	filter := r.revisionFilter(base.ID, base.Version)
+	update := bson.M{"$setOnInsert": revision}
+
+	if _, err := r.collection.UpdateOne(context.Background(), filter, update, options.Update().SetUpsert(true)); err != nil {
+		slog.Error("error saving revision", r.typeAttr(), utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetRevisions lists the stored revisions of an event, newest first.
+func (r *MongoDBRevisionRepository[T, PT]) GetRevisions(entityID primitive.ObjectID, page, pageSize int) ([]*domain.RevisionInfo, error) {
+	ctx := context.Background()
+
+	opts := options.Find().
+		SetProjection(bson.M{"revision": 1, "updatedAt": 1, "updatedBy": 1}).
+		SetSort(bson.D{{Key: "revision", Value: -1}}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	cursor, err := r.collection.Find(ctx, r.eventFilter(entityID), opts)
+	if err != nil {
+		slog.Error("error finding revisions", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	revisions := []*domain.RevisionInfo{}
+	if err := cursor.All(ctx, &revisions); err != nil {
+		slog.Error("error decoding revisions", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (r *MongoDBRevisionRepository[T, PT]) GetRevisionCount(entityID primitive.ObjectID) (int, error) {
+	count, err := r.collection.CountDocuments(context.Background(), r.eventFilter(entityID))
+	if err != nil {
+		slog.Error("error counting revisions", r.typeAttr(), utils.Err(err))
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// GetRevision returns nil when the revision is not stored.
+func (r *MongoDBRevisionRepository[T, PT]) GetRevision(entityID primitive.ObjectID, number int) (*T, error) {
+	var revision revisionDocument[T]
+
+	err := r.collection.FindOne(context.Background(), r.revisionFilter(entityID, number)).Decode(&revision)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		slog.Error("error getting revision", r.typeAttr(), utils.Err(err))
+		return nil, err
+	}
+
+	return revision.Event, nil
+}
+
+// DeleteRevisions removes every revision of the given events.
+func (r *MongoDBRevisionRepository[T, PT]) DeleteRevisions(entityIDs []primitive.ObjectID) error {
+	filter := bson.M{"entityType": r.eventType, "entityId": bson.M{"$in": entityIDs}}
+
+	if _, err := r.collection.DeleteMany(context.Background(), filter); err != nil {
+		slog.Error("error deleting revisions", r.typeAttr(), utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBRevisionRepository[T, PT]) eventFilter(entityID primitive.ObjectID) bson.M {
+	return bson.M{"entityType": r.eventType, "entityId": entityID}
+}
+
+func (r *MongoDBRevisionRepository[T, PT]) revisionFilter(entityID primitive.ObjectID, number int) bson.M {
+	return bson.M{"entityType": r.eventType, "entityId": entityID, "revision": number}
+}
+
+func (r *MongoDBRevisionRepository[T, PT]) typeAttr() slog.Attr {
+	return slog.String("eventType", string(r.eventType))
+}
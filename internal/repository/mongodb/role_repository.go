@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBRoleRepository stores role assignments. They are read on every
+// authenticated request, by subject.
+type MongoDBRoleRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDBRoleRepository(collection *mongo.Collection) *MongoDBRoleRepository {
+	return &MongoDBRoleRepository{
+		collection: collection,
+	}
+}
+
+func (r *MongoDBRoleRepository) EnsureIndexes() error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "subject", Value: 1}}},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(context.Background(), indexes); err != nil {
+		slog.Error("error creating role assignment indexes", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBRoleRepository) Create(assignment *domain.RoleAssignment) (*domain.RoleAssignment, error) {
+	assignment.ID = primitive.NilObjectID
+
+	result, err := r.collection.InsertOne(context.Background(), assignment)
+	if err != nil {
+		slog.Error("error inserting role assignment", utils.Err(err))
+		return nil, err
+	}
+
+	if id, ok := result.InsertedID.(primitive.ObjectID); ok {
+		assignment.ID = id
+	}
+
+	return assignment, nil
+}
+
+func (r *MongoDBRoleRepository) GetBySubject(subject string) ([]*domain.RoleAssignment, error) {
+	return r.find(bson.M{"subject": subject}, options.Find())
+}
+
+// GetAll lists the assignments by subject.
+func (r *MongoDBRoleRepository) GetAll(page, pageSize int) ([]*domain.RoleAssignment, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "subject", Value: 1}, {Key: "_id", Value: 1}}).
+		SetSkip(int64((page - 1) * pageSize)).
+		SetLimit(int64(pageSize))
+
+	return r.find(bson.M{}, opts)
+}
+
+func (r *MongoDBRoleRepository) GetTotalCount() (int, error) {
+	count, err := r.collection.CountDocuments(context.Background(), bson.M{})
+	if err != nil {
+		slog.Error("error counting role assignments", utils.Err(err))
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+func (r *MongoDBRoleRepository) Delete(id primitive.ObjectID) error {
+	result, err := r.collection.DeleteOne(context.Background(), bson.M{"_id": id})
+	if err != nil {
+		slog.Error("error deleting role assignment", utils.Err(err))
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrRoleNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoDBRoleRepository) find(filter bson.M, opts *options.FindOptions) ([]*domain.RoleAssignment, error) {
+	ctx := context.Background()
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		slog.Error("error finding role assignments", utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	assignments := []*domain.RoleAssignment{}
+	if err := cursor.All(ctx, &assignments); err != nil {
+		slog.Error("error decoding role assignments", utils.Err(err))
+		return nil, err
+	}
+
+	return assignments, nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"events/internal/domain"
+)
+
+func TestSearchFilter(t *testing.T) {
+	kind := (*domain.Movie)(nil).Kind()
+
+	tests := []struct {
+		name  string
+		query string
+		want  bson.M
+	}{
+		{
+			name:  "Words use the text index",
+			query: "star wars",
+			want:  bson.M{"$text": bson.M{"$search": "star wars"}},
+		},
+		{
+			name:  "Short query matches name prefixes",
+			query: "st",
+			want: bson.M{"$or": []bson.M{
+				{"name": bson.M{"$regex": "^st", "$options": "i"}},
+				{"originalName": bson.M{"$regex": "^st", "$options": "i"}},
+			}},
+		},
+		{
+			name:  "Regex metacharacters are escaped",
+			query: "(*",
+			want: bson.M{"$or": []bson.M{
+				{"name": bson.M{"$regex": `^\(\*`, "$options": "i"}},
+				{"originalName": bson.M{"$regex": `^\(\*`, "$options": "i"}},
+			}},
+		},
+		{
+			name:  "Short non-ASCII query counts runes",
+			query: "Ак",
+			want: bson.M{"$or": []bson.M{
+				{"name": bson.M{"$regex": "^Ак", "$options": "i"}},
+				{"originalName": bson.M{"$regex": "^Ак", "$options": "i"}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, searchFilter(kind, tt.query))
+		})
+	}
+}
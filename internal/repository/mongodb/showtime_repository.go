@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBShowtimeRepository keeps the showtimes of all event kinds in one
+// collection. Listings across kinds only include showtimes of published
+// events outside the trash, which takes a lookup into every event
+// collection added with AddEventSource.
+type MongoDBShowtimeRepository struct {
+	collection *mongo.Collection
+	events     []FeedSource
+}
+
+func NewMongoDBShowtimeRepository(collection *mongo.Collection) *MongoDBShowtimeRepository {
+	return &MongoDBShowtimeRepository{
+		collection: collection,
+	}
+}
+
+func (r *MongoDBShowtimeRepository) AddEventSource(source FeedSource) {
+	r.events = append(r.events, source)
+}
+
+func (r *MongoDBShowtimeRepository) EnsureIndexes() error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "startTime", Value: 1}}},
+		{Keys: bson.D{{Key: "eventType", Value: 1}, {Key: "eventId", Value: 1}, {Key: "startTime", Value: 1}}},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(context.Background(), indexes); err != nil {
+		slog.Error("error creating showtime indexes", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBShowtimeRepository) GetShowtimesByEvent(eventType domain.EventType, eventID primitive.ObjectID, from, to time.Time) ([]*domain.GetShowtimeResponse, error) {
+	filter := bson.M{
+		"eventType": eventType,
+		"eventId":   eventID,
+		"startTime": bson.M{"$gte": from, "$lt": to},
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "startTime", Value: 1}})
+
+	return r.find(filter, opts)
+}
+
+func (r *MongoDBShowtimeRepository) GetShowtimesInRange(from, to time.Time, page, pageSize int) ([]*domain.GetShowtimeResponse, error) {
+	skip := (page - 1) * pageSize
+
+	pipeline := append(r.rangePipeline(from, to),
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "startTime", Value: 1}, {Key: "_id", Value: 1}}}},
+		bson.D{{Key: "$skip", Value: skip}},
+		bson.D{{Key: "$limit", Value: pageSize}},
+	)
+
+	cursor, err := r.collection.Aggregate(context.Background(), pipeline)
+	if err != nil {
+		slog.Error("error retrieving showtime list", utils.Err(err))
+		return nil, err
+	}
+
+	var showtimes []*domain.GetShowtimeResponse
+	if err := cursor.All(context.Background(), &showtimes); err != nil {
+		slog.Error("error decoding showtimes", utils.Err(err))
+		return nil, err
+	}
+
+	return showtimes, nil
+}
+
+func (r *MongoDBShowtimeRepository) GetShowtimesInRangeCount(from, to time.Time) (int, error) {
+	pipeline := append(r.rangePipeline(from, to), bson.D{{Key: "$count", Value: "count"}})
+
+	cursor, err := r.collection.Aggregate(context.Background(), pipeline)
+	if err != nil {
+		slog.Error("error getting showtimes count", utils.Err(err))
+		return 0, err
+	}
+
+	var result []struct {
+		Count int `bson:"count"`
+	}
+	if err := cursor.All(context.Background(), &result); err != nil {
+		slog.Error("error decoding showtimes count", utils.Err(err))
+		return 0, err
+	}
+	if len(result) == 0 {
+		return 0, nil
+	}
+
+	return result[0].Count, nil
+}
+
+// rangePipeline matches the showtimes starting in the range whose event is
+// visible to everyone.
+func (r *MongoDBShowtimeRepository) rangePipeline(from, to time.Time) mongo.Pipeline {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"startTime": bson.M{"$gte": from, "$lt": to}}}},
+	}
+
+	return append(pipeline, visibleEventStages(r.events)...)
+}
+
+func (r *MongoDBShowtimeRepository) GetShowtimeByID(id primitive.ObjectID) (*domain.GetShowtimeResponse, error) {
+	filter := bson.M{"_id": id}
+
+	var showtime domain.GetShowtimeResponse
+
+	err := r.collection.FindOne(context.Background(), filter).Decode(&showtime)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		slog.Error("error getting showtime by ID", utils.Err(err))
+		return nil, err
+	}
+
+	return &showtime, nil
+}
+
+func (r *MongoDBShowtimeRepository) CreateShowtime(showtime *domain.CreateShowtimeRequest) (*domain.CreateShowtimeResponse, error) {
+	s := domain.CreateShowtimeResponse{
+		EventID:    showtime.EventID,
+		EventType:  showtime.EventType,
+		VenueID:    showtime.VenueID,
+		HallID:     showtime.HallID,
+		StartTime:  showtime.StartTime,
+		EndTime:    showtime.EndTime,
+		PriceTiers: showtime.PriceTiers,
+		Status:     showtime.Status,
+	}
+
+	result, err := r.collection.InsertOne(context.Background(), s)
+	if err != nil {
+		slog.Error("error inserting showtime document", utils.Err(err))
+		return nil, err
+	}
+
+	insertedID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		slog.Error("error getting inserted showtime ID")
+		return nil, errors.New("error getting inserted showtime ID")
+	}
+
+	s.ID = insertedID
+
+	return &s, nil
+}
+
+func (r *MongoDBShowtimeRepository) UpdateShowtime(id primitive.ObjectID, update *domain.UpdateShowtimeRequest) (*domain.UpdateShowtimeResponse, error) {
+	updateFields := bson.M{
+		"$set": bson.M{
+			"eventId":    update.EventID,
+			"eventType":  update.EventType,
+			"venueId":    update.VenueID,
+			"hallId":     update.HallID,
+			"startTime":  update.StartTime,
+			"endTime":    update.EndTime,
+			"priceTiers": update.PriceTiers,
+			"status":     update.Status,
+		},
+	}
+
+	filter := bson.M{"_id": id}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var showtime domain.UpdateShowtimeResponse
+
+	err := r.collection.FindOneAndUpdate(context.Background(), filter, updateFields, opts).Decode(&showtime)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrShowtimeNotFound
+		}
+		slog.Error("error updating showtime", utils.Err(err))
+		return nil, err
+	}
+
+	return &showtime, nil
+}
+
+func (r *MongoDBShowtimeRepository) DeleteShowtime(id primitive.ObjectID) error {
+	filter := bson.M{"_id": id}
+
+	result, err := r.collection.DeleteOne(context.Background(), filter)
+	if err != nil {
+		slog.Error("error deleting showtime", utils.Err(err))
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrShowtimeNotFound
+	}
+
+	return nil
+}
+
+// DeleteShowtimesByEvents removes every showtime of the given events, which
+// no longer exist.
+func (r *MongoDBShowtimeRepository) DeleteShowtimesByEvents(eventType domain.EventType, eventIDs []primitive.ObjectID) error {
+	filter := bson.M{"eventType": eventType, "eventId": bson.M{"$in": eventIDs}}
+
+	if _, err := r.collection.DeleteMany(context.Background(), filter); err != nil {
+		slog.Error("error deleting showtimes of events", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBShowtimeRepository) find(filter bson.M, opts *options.FindOptions) ([]*domain.GetShowtimeResponse, error) {
+	cursor, err := r.collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		slog.Error("error retrieving showtime list", utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	var showtimes []*domain.GetShowtimeResponse
+	for cursor.Next(context.Background()) {
+		var showtime domain.GetShowtimeResponse
+		if err := cursor.Decode(&showtime); err != nil {
+			slog.Error("error decoding showtime", utils.Err(err))
+			return nil, err
+		}
+		showtimes = append(showtimes, &showtime)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return showtimes, nil
+}
+
+// visibleEventStages keep the showtimes whose event is published and not in
+// the trash. A lookup
+// can't choose its collection per document, so there is one for every event
+// collection, and a showtime is kept if the one of its own kind found the
+// event.
+func visibleEventStages(sources []FeedSource) mongo.Pipeline {
+	if len(sources) == 0 {
+		return mongo.Pipeline{{{Key: "$match", Value: bson.M{"$expr": false}}}}
+	}
+
+	var (
+		stages     mongo.Pipeline
+		conditions []bson.M
+		lookups    = bson.M{}
+	)
+	for _, source := range sources {
+		kind := source.Kind()
+		field := "visibleEvent_" + string(kind.Type)
+
+		stages = append(stages, bson.D{{Key: "$lookup", Value: bson.M{
+			"from":         source.Collection().Name(),
+			"localField":   "eventId",
+			"foreignField": "_id",
+			"pipeline": bson.A{
+				bson.M{"$match": notDeleted(published(bson.M{}))},
+				bson.M{"$project": bson.M{"_id": 1}},
+			},
+			"as": field,
+		}}})
+		conditions = append(conditions, bson.M{"eventType": kind.Type, field + ".0": bson.M{"$exists": true}})
+		lookups[field] = 0
+	}
+
+	return append(stages,
+		bson.D{{Key: "$match", Value: bson.M{"$or": conditions}}},
+		bson.D{{Key: "$project", Value: lookups}},
+	)
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"events/internal/domain"
+)
+
+func TestSortDocument(t *testing.T) {
+	fallback := bson.D{{Key: "_id", Value: 1}}
+
+	tests := []struct {
+		name    string
+		kind    domain.EventKind
+		sort    string
+		want    bson.D
+		wantErr error
+	}{
+		{
+			name: "No sort keeps the default order",
+			kind: (*domain.Movie)(nil).Kind(),
+			sort: "",
+			want: fallback,
+		},
+		{
+			name: "Keys in order with an _id tiebreaker",
+			kind: (*domain.Movie)(nil).Kind(),
+			sort: "-releaseDate,name",
+			want: bson.D{{Key: "releaseDate", Value: -1}, {Key: "name", Value: 1}, {Key: "_id", Value: 1}},
+		},
+		{
+			name: "Created sorts by _id without a tiebreaker",
+			kind: (*domain.Performance)(nil).Kind(),
+			sort: "-popularity,-created",
+			want: bson.D{{Key: "popularity", Value: -1}, {Key: "_id", Value: -1}},
+		},
+		{
+			name:    "Date fields of other kinds are rejected",
+			kind:    (*domain.Performance)(nil).Kind(),
+			sort:    "releaseDate",
+			wantErr: domain.ErrInvalidSort,
+		},
+		{
+			name:    "Stored field names are not accepted",
+			kind:    (*domain.Movie)(nil).Kind(),
+			sort:    "_id",
+			wantErr: domain.ErrInvalidSort,
+		},
+		{
+			name:    "Repeated fields are rejected",
+			kind:    (*domain.Movie)(nil).Kind(),
+			sort:    "name,-name",
+			wantErr: domain.ErrInvalidSort,
+		},
+		{
+			name:    "Too many keys",
+			kind:    (*domain.Movie)(nil).Kind(),
+			sort:    "name,popularity,created,releaseDate",
+			wantErr: domain.ErrInvalidSort,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort, err := domain.ParseSort(tt.sort, tt.kind.SortFields())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, sortDocument(sort, fallback))
+		})
+	}
+}
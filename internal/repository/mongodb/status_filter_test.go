@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+
+	"events/internal/domain"
+)
+
+func TestWithStatuses(t *testing.T) {
+	tags := bson.M{"tags": bson.M{"$in": []string{"imax"}}}
+
+	tests := []struct {
+		name     string
+		filter   bson.M
+		statuses []domain.PublicationStatus
+		want     bson.M
+	}{
+		{
+			name:   "Published by default",
+			filter: bson.M{},
+			want:   bson.M{"status": domain.StatusPublished},
+		},
+		{
+			name:     "Requested statuses",
+			filter:   tags,
+			statuses: []domain.PublicationStatus{domain.StatusDraft, domain.StatusScheduled},
+			want: bson.M{"$and": []bson.M{
+				tags,
+				{"status": bson.M{"$in": []domain.PublicationStatus{domain.StatusDraft, domain.StatusScheduled}}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, withStatuses(tt.filter, tt.statuses))
+		})
+	}
+}
@@ -22,7 +22,7 @@ func TestGetAllPerformances(t *testing.T) {
 		name     string
 		page     int
 		pageSize int
-		want     []*domain.GetPerformanceResponse
+		want     []*domain.Performance
 		wantErr  bool
 		err      error
 	}{
@@ -30,28 +30,32 @@ func TestGetAllPerformances(t *testing.T) {
 			name:     "Successful retrieval",
 			page:     1,
 			pageSize: 10,
-			want: []*domain.GetPerformanceResponse{
+			want: []*domain.Performance{
 				{
-					ID:          primitive.NewObjectID(),
-					Cover:       "cover1.jpg",
-					Name:        "Test Performance 1",
-					Description: "This is a test performance 1",
-					Duration:    "120 mins",
-					Age:         "18+",
-					Categories:  []string{"Action", "Adventure"},
-					Tags:        []string{"test", "performance"},
-					Media:       []string{"media1", "media2"},
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover1.jpg",
+						Name:        "Test Performance 1",
+						Description: "This is a test performance 1",
+						Duration:    120,
+						Age:         &adultsOnly,
+						Categories:  []string{"Action", "Adventure"},
+						Tags:        []string{"test", "performance"},
+						Media:       []string{"media1", "media2"},
+					},
 				},
 				{
-					ID:          primitive.NewObjectID(),
-					Cover:       "cover2.jpg",
-					Name:        "Test Performance 2",
-					Description: "This is a test performance 2",
-					Duration:    "150 mins",
-					Age:         "18+",
-					Categories:  []string{"Comedy", "Drama"},
-					Tags:        []string{"test", "performance"},
-					Media:       []string{"media3", "media4"},
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover2.jpg",
+						Name:        "Test Performance 2",
+						Description: "This is a test performance 2",
+						Duration:    150,
+						Age:         &adultsOnly,
+						Categories:  []string{"Comedy", "Drama"},
+						Tags:        []string{"test", "performance"},
+						Media:       []string{"media3", "media4"},
+					},
 				},
 			},
 			wantErr: false,
@@ -77,15 +81,15 @@ func TestGetAllPerformances(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().GetAllPerformances(tt.page, tt.pageSize).Return(tt.want, tt.err)
+			mockRepo.EXPECT().GetAll(domain.EventFilter{}, nil, tt.page, tt.pageSize).Return(tt.want, tt.err)
 
-			got, err := mockRepo.GetAllPerformances(tt.page, tt.pageSize)
+			got, err := mockRepo.GetAll(domain.EventFilter{}, nil, tt.page, tt.pageSize)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GetAllPerformances() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GetAll() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GetAllPerformances() got = %v, want %v", got, tt.want)
+				t.Errorf("GetAll() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -100,23 +104,25 @@ func TestGetPerformanceByID(t *testing.T) {
 	tests := []struct {
 		name    string
 		id      primitive.ObjectID
-		want    *domain.GetPerformanceResponse
+		want    *domain.Performance
 		wantErr bool
 		err     error
 	}{
 		{
 			name: "Successful retrieval",
 			id:   primitive.NewObjectID(),
-			want: &domain.GetPerformanceResponse{
-				ID:          primitive.NewObjectID(),
-				Cover:       "cover.jpg",
-				Name:        "Test Performance",
-				Description: "This is a test performance",
-				Duration:    "120 mins",
-				Age:         "18+",
-				Categories:  []string{"Action", "Adventure"},
-				Tags:        []string{"test", "performance"},
-				Media:       []string{"media1", "media2"},
+			want: &domain.Performance{
+				EventBase: domain.EventBase{
+					ID:          primitive.NewObjectID(),
+					Cover:       "cover.jpg",
+					Name:        "Test Performance",
+					Description: "This is a test performance",
+					Duration:    120,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"test", "performance"},
+					Media:       []string{"media1", "media2"},
+				},
 			},
 			wantErr: false,
 			err:     nil,
@@ -139,15 +145,15 @@ func TestGetPerformanceByID(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().GetPerformanceByID(tt.id).Return(tt.want, tt.err)
+			mockRepo.EXPECT().GetByID(tt.id).Return(tt.want, tt.err)
 
-			got, err := mockRepo.GetPerformanceByID(tt.id)
+			got, err := mockRepo.GetByID(tt.id)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("GetPerformanceByID() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("GetByID() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("GetPerformanceByID() got = %v, want %v", got, tt.want)
+				t.Errorf("GetByID() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -161,48 +167,54 @@ func TestCreatePerformance(t *testing.T) {
 
 	tests := []struct {
 		name    string
-		request *domain.CreatePerformanceRequest
-		want    *domain.CreatePerformanceResponse
+		request *domain.Performance
+		want    *domain.Performance
 		wantErr bool
 		err     error
 	}{
 		{
 			name: "Successful creation",
-			request: &domain.CreatePerformanceRequest{
-				Cover:       "cover.jpg",
-				Name:        "Test Performance",
-				Description: "This is a test performance",
-				Duration:    "120 mins",
-				Age:         "18+",
-				Categories:  []string{"Action", "Adventure"},
-				Tags:        []string{"test", "performance"},
-				Media:       []string{"media1", "media2"},
+			request: &domain.Performance{
+				EventBase: domain.EventBase{
+					Cover:       "cover.jpg",
+					Name:        "Test Performance",
+					Description: "This is a test performance",
+					Duration:    120,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"test", "performance"},
+					Media:       []string{"media1", "media2"},
+				},
 			},
-			want: &domain.CreatePerformanceResponse{
-				ID:          primitive.NewObjectID(),
-				Cover:       "cover.jpg",
-				Name:        "Test Performance",
-				Description: "This is a test performance",
-				Duration:    "120 mins",
-				Age:         "18+",
-				Categories:  []string{"Action", "Adventure"},
-				Tags:        []string{"test", "performance"},
-				Media:       []string{"media1", "media2"},
+			want: &domain.Performance{
+				EventBase: domain.EventBase{
+					ID:          primitive.NewObjectID(),
+					Cover:       "cover.jpg",
+					Name:        "Test Performance",
+					Description: "This is a test performance",
+					Duration:    120,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"test", "performance"},
+					Media:       []string{"media1", "media2"},
+				},
 			},
 			wantErr: false,
 			err:     nil,
 		},
 		{
 			name: "Error inserting performance document",
-			request: &domain.CreatePerformanceRequest{
-				Cover:       "cover.jpg",
-				Name:        "Test Performance",
-				Description: "This is a test performance",
-				Duration:    "120 mins",
-				Age:         "18+",
-				Categories:  []string{"Action", "Adventure"},
-				Tags:        []string{"test", "performance"},
-				Media:       []string{"media1", "media2"},
+			request: &domain.Performance{
+				EventBase: domain.EventBase{
+					Cover:       "cover.jpg",
+					Name:        "Test Performance",
+					Description: "This is a test performance",
+					Duration:    120,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"test", "performance"},
+					Media:       []string{"media1", "media2"},
+				},
 			},
 			want:    nil,
 			wantErr: true,
@@ -210,15 +222,17 @@ func TestCreatePerformance(t *testing.T) {
 		},
 		{
 			name: "Error getting inserted performance ID",
-			request: &domain.CreatePerformanceRequest{
-				Cover:       "cover.jpg",
-				Name:        "Test Performance",
-				Description: "This is a test performance",
-				Duration:    "120 mins",
-				Age:         "18+",
-				Categories:  []string{"Action", "Adventure"},
-				Tags:        []string{"test", "performance"},
-				Media:       []string{"media1", "media2"},
+			request: &domain.Performance{
+				EventBase: domain.EventBase{
+					Cover:       "cover.jpg",
+					Name:        "Test Performance",
+					Description: "This is a test performance",
+					Duration:    120,
+					Age:         &adultsOnly,
+					Categories:  []string{"Action", "Adventure"},
+					Tags:        []string{"test", "performance"},
+					Media:       []string{"media1", "media2"},
+				},
 			},
 			want:    nil,
 			wantErr: true,
@@ -228,15 +242,15 @@ func TestCreatePerformance(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().CreatePerformance(tt.request).Return(tt.want, tt.err)
+			mockRepo.EXPECT().Create(tt.request).Return(tt.want, tt.err)
 
-			got, err := mockRepo.CreatePerformance(tt.request)
+			got, err := mockRepo.Create(tt.request)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("CreatePerformance() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("CreatePerformance() got = %v, want %v", got, tt.want)
+				t.Errorf("Create() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -251,34 +265,38 @@ func TestUpdatePerformance(t *testing.T) {
 	tests := []struct {
 		name    string
 		id      primitive.ObjectID
-		update  *domain.UpdatePerformanceRequest
-		want    *domain.UpdatePerformanceResponse
+		update  *domain.Performance
+		want    *domain.Performance
 		wantErr bool
 		err     error
 	}{
 		{
 			name: "Successful update",
 			id:   primitive.NewObjectID(),
-			update: &domain.UpdatePerformanceRequest{
-				Cover:       "new_cover.jpg",
-				Name:        "New Performance",
-				Description: "This is a new performance",
-				Duration:    "150 mins",
-				Age:         "18+",
-				Categories:  []string{"Drama", "Thriller"},
-				Tags:        []string{"new", "performance"},
-				Media:       []string{"media3", "media4"},
+			update: &domain.Performance{
+				EventBase: domain.EventBase{
+					Cover:       "new_cover.jpg",
+					Name:        "New Performance",
+					Description: "This is a new performance",
+					Duration:    150,
+					Age:         &adultsOnly,
+					Categories:  []string{"Drama", "Thriller"},
+					Tags:        []string{"new", "performance"},
+					Media:       []string{"media3", "media4"},
+				},
 			},
-			want: &domain.UpdatePerformanceResponse{
-				ID:          primitive.NewObjectID(),
-				Cover:       "new_cover.jpg",
-				Name:        "New Performance",
-				Description: "This is a new performance",
-				Duration:    "150 mins",
-				Age:         "18+",
-				Categories:  []string{"Drama", "Thriller"},
-				Tags:        []string{"new", "performance"},
-				Media:       []string{"media3", "media4"},
+			want: &domain.Performance{
+				EventBase: domain.EventBase{
+					ID:          primitive.NewObjectID(),
+					Cover:       "new_cover.jpg",
+					Name:        "New Performance",
+					Description: "This is a new performance",
+					Duration:    150,
+					Age:         &adultsOnly,
+					Categories:  []string{"Drama", "Thriller"},
+					Tags:        []string{"new", "performance"},
+					Media:       []string{"media3", "media4"},
+				},
 			},
 			wantErr: false,
 			err:     nil,
@@ -286,7 +304,7 @@ func TestUpdatePerformance(t *testing.T) {
 		{
 			name:    "Error updating performance",
 			id:      primitive.NewObjectID(),
-			update:  &domain.UpdatePerformanceRequest{},
+			update:  &domain.Performance{},
 			want:    nil,
 			wantErr: true,
 			err:     errors.New("error updating performance"),
@@ -294,7 +312,7 @@ func TestUpdatePerformance(t *testing.T) {
 		{
 			name:    "Error fetching updated performance",
 			id:      primitive.NewObjectID(),
-			update:  &domain.UpdatePerformanceRequest{},
+			update:  &domain.Performance{},
 			want:    nil,
 			wantErr: true,
 			err:     errors.New("error fetching updated performance"),
@@ -303,15 +321,15 @@ func TestUpdatePerformance(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().UpdatePerformance(tt.id, tt.update).Return(tt.want, tt.err)
+			mockRepo.EXPECT().Update(tt.id, tt.update, 0).Return(tt.want, tt.err)
 
-			got, err := mockRepo.UpdatePerformance(tt.id, tt.update)
+			got, err := mockRepo.Update(tt.id, tt.update, 0)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("UpdatePerformance() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("UpdatePerformance() got = %v, want %v", got, tt.want)
+				t.Errorf("Update() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -328,7 +346,7 @@ func TestSearchPerformances(t *testing.T) {
 		query    string
 		page     int
 		pageSize int
-		want     []*domain.GetPerformanceResponse
+		want     []*domain.Performance
 		wantErr  bool
 		err      error
 	}{
@@ -337,28 +355,32 @@ func TestSearchPerformances(t *testing.T) {
 			query:    "Test Performance",
 			page:     1,
 			pageSize: 10,
-			want: []*domain.GetPerformanceResponse{
+			want: []*domain.Performance{
 				{
-					ID:          primitive.NewObjectID(),
-					Cover:       "cover1.jpg",
-					Name:        "Test Performance 1",
-					Description: "This is a test performance 1",
-					Duration:    "120 mins",
-					Age:         "18+",
-					Categories:  []string{"Action", "Adventure"},
-					Tags:        []string{"test", "performance"},
-					Media:       []string{"media1", "media2"},
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover1.jpg",
+						Name:        "Test Performance 1",
+						Description: "This is a test performance 1",
+						Duration:    120,
+						Age:         &adultsOnly,
+						Categories:  []string{"Action", "Adventure"},
+						Tags:        []string{"test", "performance"},
+						Media:       []string{"media1", "media2"},
+					},
 				},
 				{
-					ID:          primitive.NewObjectID(),
-					Cover:       "cover2.jpg",
-					Name:        "Test Performance 2",
-					Description: "This is a test performance 2",
-					Duration:    "150 mins",
-					Age:         "18+",
-					Categories:  []string{"Comedy", "Drama"},
-					Tags:        []string{"test", "performance"},
-					Media:       []string{"media3", "media4"},
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover2.jpg",
+						Name:        "Test Performance 2",
+						Description: "This is a test performance 2",
+						Duration:    150,
+						Age:         &adultsOnly,
+						Categories:  []string{"Comedy", "Drama"},
+						Tags:        []string{"test", "performance"},
+						Media:       []string{"media3", "media4"},
+					},
 				},
 			},
 			wantErr: false,
@@ -386,15 +408,15 @@ func TestSearchPerformances(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().SearchPerformances(tt.query, tt.page, tt.pageSize).Return(tt.want, tt.err)
+			mockRepo.EXPECT().Search(tt.query, nil, tt.page, tt.pageSize).Return(tt.want, tt.err)
 
-			got, err := mockRepo.SearchPerformances(tt.query, tt.page, tt.pageSize)
+			got, err := mockRepo.Search(tt.query, nil, tt.page, tt.pageSize)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("SearchPerformances() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("Search() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("SearchPerformances() got = %v, want %v", got, tt.want)
+				t.Errorf("Search() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -411,7 +433,7 @@ func TestFilterPerformancesByTags(t *testing.T) {
 		tags     []string
 		page     int
 		pageSize int
-		want     []*domain.GetPerformanceResponse
+		want     []*domain.Performance
 		wantErr  bool
 		err      error
 	}{
@@ -420,28 +442,32 @@ func TestFilterPerformancesByTags(t *testing.T) {
 			tags:     []string{"Action", "Adventure"},
 			page:     1,
 			pageSize: 10,
-			want: []*domain.GetPerformanceResponse{
+			want: []*domain.Performance{
 				{
-					ID:          primitive.NewObjectID(),
-					Cover:       "cover1.jpg",
-					Name:        "Test Performance 1",
-					Description: "This is a test performance 1",
-					Duration:    "120 mins",
-					Age:         "18+",
-					Categories:  []string{"Action", "Adventure"},
-					Tags:        []string{"test", "performance"},
-					Media:       []string{"media1", "media2"},
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover1.jpg",
+						Name:        "Test Performance 1",
+						Description: "This is a test performance 1",
+						Duration:    120,
+						Age:         &adultsOnly,
+						Categories:  []string{"Action", "Adventure"},
+						Tags:        []string{"test", "performance"},
+						Media:       []string{"media1", "media2"},
+					},
 				},
 				{
-					ID:          primitive.NewObjectID(),
-					Cover:       "cover2.jpg",
-					Name:        "Test Performance 2",
-					Description: "This is a test performance 2",
-					Duration:    "150 mins",
-					Age:         "18+",
-					Categories:  []string{"Comedy", "Drama"},
-					Tags:        []string{"test", "performance"},
-					Media:       []string{"media3", "media4"},
+					EventBase: domain.EventBase{
+						ID:          primitive.NewObjectID(),
+						Cover:       "cover2.jpg",
+						Name:        "Test Performance 2",
+						Description: "This is a test performance 2",
+						Duration:    150,
+						Age:         &adultsOnly,
+						Categories:  []string{"Comedy", "Drama"},
+						Tags:        []string{"test", "performance"},
+						Media:       []string{"media3", "media4"},
+					},
 				},
 			},
 			wantErr: false,
@@ -469,15 +495,15 @@ func TestFilterPerformancesByTags(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			mockRepo.EXPECT().FilterPerformancesByTags(tt.tags, tt.page, tt.pageSize).Return(tt.want, tt.err)
+			mockRepo.EXPECT().FilterByTags(tt.tags, nil, tt.page, tt.pageSize).Return(tt.want, tt.err)
 
-			got, err := mockRepo.FilterPerformancesByTags(tt.tags, tt.page, tt.pageSize)
+			got, err := mockRepo.FilterByTags(tt.tags, nil, tt.page, tt.pageSize)
 			if (err != nil) != tt.wantErr {
-				t.Errorf("FilterPerformancesByTags() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("FilterByTags() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("FilterPerformancesByTags() got = %v, want %v", got, tt.want)
+				t.Errorf("FilterByTags() got = %v, want %v", got, tt.want)
 			}
 		})
 	}
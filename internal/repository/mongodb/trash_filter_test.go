@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestTrashFilters(t *testing.T) {
+	id := primitive.NewObjectID()
+	live := bson.M{"deletedAt": bson.M{"$exists": false}}
+	trashed := bson.M{"deletedAt": bson.M{"$exists": true}}
+
+	tests := []struct {
+		name string
+		got  bson.M
+		want bson.M
+	}{
+		{
+			name: "Empty filter becomes the condition",
+			got:  notDeleted(bson.M{}),
+			want: live,
+		},
+		{
+			name: "Filter is combined with the condition",
+			got:  notDeleted(bson.M{"_id": id}),
+			want: bson.M{"$and": []bson.M{{"_id": id}, live}},
+		},
+		{
+			name: "Text search stays a top-level clause of $and",
+			got:  notDeleted(bson.M{"$text": bson.M{"$search": "star wars"}}),
+			want: bson.M{"$and": []bson.M{{"$text": bson.M{"$search": "star wars"}}, live}},
+		},
+		{
+			name: "Trash",
+			got:  deleted(bson.M{}),
+			want: trashed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.got)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoDBUserRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDBUserRepository(collection *mongo.Collection) *MongoDBUserRepository {
+	return &MongoDBUserRepository{
+		collection: collection,
+	}
+}
+
+func (r *MongoDBUserRepository) EnsureIndexes() error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "email", Value: 1}}, Options: options.Index().SetUnique(true)},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(context.Background(), indexes); err != nil {
+		slog.Error("error creating user indexes", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// Create stores a new user. The unique email index turns a race between
+// two registrations of one email into ErrEmailTaken.
+func (r *MongoDBUserRepository) Create(user *domain.User) (*domain.User, error) {
+	user.ID = primitive.NilObjectID
+
+	result, err := r.collection.InsertOne(context.Background(), user)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, domain.ErrEmailTaken
+	}
+	if err != nil {
+		slog.Error("error inserting user", utils.Err(err))
+		return nil, err
+	}
+
+	if id, ok := result.InsertedID.(primitive.ObjectID); ok {
+		user.ID = id
+	}
+
+	return user, nil
+}
+
+func (r *MongoDBUserRepository) GetByID(id primitive.ObjectID) (*domain.User, error) {
+	return r.findOne(bson.M{"_id": id})
+}
+
+func (r *MongoDBUserRepository) GetByEmail(email string) (*domain.User, error) {
+	return r.findOne(bson.M{"email": email})
+}
+
+func (r *MongoDBUserRepository) UpdatePassword(id primitive.ObjectID, passwordHash string) error {
+	update := bson.M{"$set": bson.M{"passwordHash": passwordHash, "updatedAt": time.Now().UTC()}}
+
+	result, err := r.collection.UpdateOne(context.Background(), bson.M{"_id": id}, update)
+	if err != nil {
+		slog.Error("error updating user password", utils.Err(err))
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return domain.ErrUserNotFound
+	}
+
+	return nil
+}
+
+func (r *MongoDBUserRepository) findOne(filter bson.M) (*domain.User, error) {
+	var user domain.User
+	if err := r.collection.FindOne(context.Background(), filter).Decode(&user); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrUserNotFound
+		}
+		slog.Error("error finding user", utils.Err(err))
+		return nil, err
+	}
+
+	return &user, nil
+}
@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MongoDBUserTokenRepository stores refresh and password reset tokens by
+// their hash. MongoDB removes them once they have expired.
+type MongoDBUserTokenRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDBUserTokenRepository(collection *mongo.Collection) *MongoDBUserTokenRepository {
+	return &MongoDBUserTokenRepository{
+		collection: collection,
+	}
+}
+
+func (r *MongoDBUserTokenRepository) EnsureIndexes() error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "kind", Value: 1}, {Key: "hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "family", Value: 1}}},
+		{Keys: bson.D{{Key: "userId", Value: 1}, {Key: "kind", Value: 1}}},
+		{Keys: bson.D{{Key: "expiresAt", Value: 1}}, Options: options.Index().SetExpireAfterSeconds(0)},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(context.Background(), indexes); err != nil {
+		slog.Error("error creating user token indexes", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBUserTokenRepository) Create(token *domain.UserToken) error {
+	token.ID = primitive.NilObjectID
+
+	result, err := r.collection.InsertOne(context.Background(), token)
+	if err != nil {
+		slog.Error("error inserting user token", utils.Err(err))
+		return err
+	}
+
+	if id, ok := result.InsertedID.(primitive.ObjectID); ok {
+		token.ID = id
+	}
+
+	return nil
+}
+
+// GetByHash returns a token whether or not it is still usable.
+func (r *MongoDBUserTokenRepository) GetByHash(kind domain.UserTokenKind, hash string) (*domain.UserToken, error) {
+	var token domain.UserToken
+	if err := r.collection.FindOne(context.Background(), bson.M{"kind": kind, "hash": hash}).Decode(&token); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrTokenNotFound
+		}
+		slog.Error("error finding user token", utils.Err(err))
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Use marks an unused, unrevoked and unexpired token as used and returns
+// it. Of two concurrent uses only one succeeds.
+func (r *MongoDBUserTokenRepository) Use(kind domain.UserTokenKind, hash string, now time.Time) (*domain.UserToken, error) {
+	filter := bson.M{
+		"kind":      kind,
+		"hash":      hash,
+		"usedAt":    bson.M{"$exists": false},
+		"revokedAt": bson.M{"$exists": false},
+		"expiresAt": bson.M{"$gt": now},
+	}
+	update := bson.M{"$set": bson.M{"usedAt": now}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var token domain.UserToken
+	if err := r.collection.FindOneAndUpdate(context.Background(), filter, update, opts).Decode(&token); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, domain.ErrTokenNotFound
+		}
+		slog.Error("error using user token", utils.Err(err))
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *MongoDBUserTokenRepository) RevokeFamily(family primitive.ObjectID, now time.Time) error {
+	return r.revoke(bson.M{"family": family}, now)
+}
+
+func (r *MongoDBUserTokenRepository) RevokeUserTokens(userID primitive.ObjectID, kind domain.UserTokenKind, now time.Time) error {
+	return r.revoke(bson.M{"userId": userID, "kind": kind}, now)
+}
+
+func (r *MongoDBUserTokenRepository) revoke(filter bson.M, now time.Time) error {
+	filter["revokedAt"] = bson.M{"$exists": false}
+	update := bson.M{"$set": bson.M{"revokedAt": now}}
+
+	if _, err := r.collection.UpdateMany(context.Background(), filter, update); err != nil {
+		slog.Error("error revoking user tokens", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type MongoDBVenueRepository struct {
+	collection *mongo.Collection
+}
+
+func NewMongoDBVenueRepository(collection *mongo.Collection) *MongoDBVenueRepository {
+	return &MongoDBVenueRepository{
+		collection: collection,
+	}
+}
+
+func (r *MongoDBVenueRepository) EnsureIndexes() error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "location", Value: "2dsphere"}}},
+		{Keys: bson.D{{Key: "city", Value: 1}, {Key: "name", Value: 1}}},
+		{Keys: bson.D{{Key: "name", Value: 1}}},
+	}
+
+	if _, err := r.collection.Indexes().CreateMany(context.Background(), indexes); err != nil {
+		slog.Error("error creating venue indexes", utils.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (r *MongoDBVenueRepository) GetAllVenues(sort domain.Sort, page, pageSize int) ([]*domain.GetVenueResponse, error) {
+	skip := (page - 1) * pageSize
+
+	filter := bson.M{}
+
+	opts := options.Find().
+		SetSort(sortDocument(sort, bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}})).
+		SetSkip(int64(skip)).
+		SetLimit(int64(pageSize))
+
+	cursor, err := r.collection.Find(context.Background(), filter, opts)
+	if err != nil {
+		slog.Error("error retrieving venue list", utils.Err(err))
+		return nil, err
+	}
+	defer cursor.Close(context.Background())
+
+	var venues []*domain.GetVenueResponse
+	for cursor.Next(context.Background()) {
+		var venue domain.GetVenueResponse
+		if err := cursor.Decode(&venue); err != nil {
+			slog.Error("error decoding venue", utils.Err(err))
+			return nil, err
+		}
+		venues = append(venues, &venue)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return venues, nil
+}
+
+func (r *MongoDBVenueRepository) GetTotalVenuesCount() (int, error) {
+	filter := bson.M{}
+
+	totalVenues, err := r.collection.CountDocuments(context.Background(), filter)
+	if err != nil {
+		slog.Error("error getting total venues count", utils.Err(err))
+		return 0, err
+	}
+
+	return int(totalVenues), nil
+}
+
+func (r *MongoDBVenueRepository) GetVenueByID(id primitive.ObjectID) (*domain.GetVenueResponse, error) {
+	filter := bson.M{"_id": id}
+
+	var venue domain.GetVenueResponse
+
+	err := r.collection.FindOne(context.Background(), filter).Decode(&venue)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		slog.Error("error getting venue by ID", utils.Err(err))
+		return nil, err
+	}
+
+	return &venue, nil
+}
+
+func (r *MongoDBVenueRepository) CreateVenue(venue *domain.CreateVenueRequest) (*domain.CreateVenueResponse, error) {
+	v := domain.CreateVenueResponse{
+		Cover:       venue.Cover,
+		Name:        venue.Name,
+		Description: venue.Description,
+		Address:     venue.Address,
+		City:        venue.City,
+		Phone:       venue.Phone,
+		Location:    venue.Location,
+	}
+
+	result, err := r.collection.InsertOne(context.Background(), v)
+	if err != nil {
+		slog.Error("error inserting venue document", utils.Err(err))
+		return nil, err
+	}
+
+	insertedID, ok := result.InsertedID.(primitive.ObjectID)
+	if !ok {
+		slog.Error("error getting inserted venue ID")
+		return nil, errors.New("error getting inserted venue ID")
+	}
+
+	v.ID = insertedID
+
+	return &v, nil
+}
+
+func (r *MongoDBVenueRepository) UpdateVenue(id primitive.ObjectID, update *domain.UpdateVenueRequest) (*domain.UpdateVenueResponse, error) {
+	updateFields := bson.M{
+		"$set": bson.M{
+			"cover":       update.Cover,
+			"name":        update.Name,
+			"description": update.Description,
+			"address":     update.Address,
+			"city":        update.City,
+			"phone":       update.Phone,
+			"location":    update.Location,
+		},
+	}
+
+	filter := bson.M{"_id": id}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var venue domain.UpdateVenueResponse
+
+	err := r.collection.FindOneAndUpdate(context.Background(), filter, updateFields, opts).Decode(&venue)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, domain.ErrVenueNotFound
+		}
+		slog.Error("error updating venue", utils.Err(err))
+		return nil, err
+	}
+
+	return &venue, nil
+}
+
+func (r *MongoDBVenueRepository) DeleteVenue(id primitive.ObjectID) error {
+	filter := bson.M{"_id": id}
+
+	result, err := r.collection.DeleteOne(context.Background(), filter)
+	if err != nil {
+		slog.Error("error deleting venue", utils.Err(err))
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return domain.ErrVenueNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+
+	"events/internal/domain"
+)
+
+func TestVisibleEventStages(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+
+	mt.Run("One lookup per event collection", func(mt *mtest.T) {
+		movies := feedSourceStub{kind: (*domain.Movie)(nil).Kind(), collection: mt.DB.Collection("movies")}
+		concerts := feedSourceStub{kind: (*domain.Concert)(nil).Kind(), collection: mt.DB.Collection("concerts")}
+
+		lookup := func(collection, field string) bson.D {
+			return bson.D{{Key: "$lookup", Value: bson.M{
+				"from":         collection,
+				"localField":   "eventId",
+				"foreignField": "_id",
+				"pipeline": bson.A{
+					bson.M{"$match": bson.M{"$and": []bson.M{
+						{"status": domain.StatusPublished},
+						{"deletedAt": bson.M{"$exists": false}},
+					}}},
+					bson.M{"$project": bson.M{"_id": 1}},
+				},
+				"as": field,
+			}}}
+		}
+
+		want := mongo.Pipeline{
+			lookup("movies", "visibleEvent_movie"),
+			lookup("concerts", "visibleEvent_concert"),
+			{{Key: "$match", Value: bson.M{"$or": []bson.M{
+				{"eventType": domain.EventTypeMovie, "visibleEvent_movie.0": bson.M{"$exists": true}},
+				{"eventType": domain.EventTypeConcert, "visibleEvent_concert.0": bson.M{"$exists": true}},
+			}}}},
+			{{Key: "$project", Value: bson.M{"visibleEvent_movie": 0, "visibleEvent_concert": 0}}},
+		}
+
+		assert.Equal(mt, want, visibleEventStages([]FeedSource{movies, concerts}))
+	})
+
+	mt.Run("No event collections match nothing", func(mt *mtest.T) {
+		want := mongo.Pipeline{{{Key: "$match", Value: bson.M{"$expr": false}}}}
+
+		assert.Equal(mt, want, visibleEventStages(nil))
+	})
+}
@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"events/internal/domain"
+	mock_repository "events/internal/repository/mocks"
+	"events/internal/service"
+)
+
+// callerContext returns the context of a request by subject holding role
+// for the given event types, or for the whole catalog.
+func callerContext(subject string, role domain.Role, eventTypes ...domain.EventType) context.Context {
+	ctx := domain.WithPrincipal(context.Background(), &domain.Principal{
+		Subject: subject,
+		Method:  domain.AuthToken,
+		Roles:   []domain.RoleAssignment{{Subject: subject, Role: role, EventTypes: eventTypes}},
+	})
+	return domain.WithActor(ctx, subject)
+}
+
+func TestEventAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := primitive.NewObjectID()
+	releaseDate := time.Date(2021, 10, 22, 0, 0, 0, 0, time.UTC)
+	stored := &domain.Movie{
+		EventBase:   domain.EventBase{ID: id, Name: "Dune", Status: domain.StatusPublished, Version: 3},
+		ReleaseDate: releaseDate,
+	}
+
+	movieEditor := callerContext("movie-editor", domain.RoleEditor, domain.EventTypeMovie)
+	theatreEditor := callerContext("theatre-editor", domain.RoleEditor, domain.EventTypePerformance)
+	moviePublisher := callerContext("movie-publisher", domain.RolePublisher, domain.EventTypeMovie)
+
+	newService := func(movieRepo *mock_repository.MockMovieRepository) *service.EventService[domain.Movie, *domain.Movie] {
+		auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+		auditRepo.EXPECT().Record(gomock.Any()).Return(nil).AnyTimes()
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+		revisionRepo.EXPECT().Save(gomock.Any()).Return(nil).AnyTimes()
+		return service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+	}
+
+	t.Run("Create", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			ctx        context.Context
+			status     domain.PublicationStatus
+			wantStatus domain.PublicationStatus
+			wantErr    error
+		}{
+			{name: "Anonymous", ctx: context.Background(), wantErr: domain.ErrForbidden},
+			{name: "Editor of another type", ctx: theatreEditor, wantErr: domain.ErrForbidden},
+			{name: "Editor keeps new events in draft", ctx: movieEditor, wantStatus: domain.StatusDraft},
+			{name: "Editor can't publish", ctx: movieEditor, status: domain.StatusPublished, wantErr: domain.ErrForbidden},
+			{name: "Publisher publishes", ctx: moviePublisher, wantStatus: domain.StatusPublished},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+				if tt.wantErr == nil {
+					movieRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(movie *domain.Movie) (*domain.Movie, error) {
+						return movie, nil
+					})
+				}
+
+				movie := &domain.Movie{EventBase: domain.EventBase{Name: "Dune", Status: tt.status}, ReleaseDate: releaseDate}
+				created, err := newService(movieRepo).Create(tt.ctx, movie)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantStatus, created.Status)
+			})
+		}
+	})
+
+	t.Run("Patch", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			ctx     context.Context
+			patch   string
+			wantErr error
+		}{
+			{name: "Editor edits content", ctx: movieEditor, patch: `{"name": "Dune: Part One"}`},
+			{name: "Editor can't unpublish", ctx: movieEditor, patch: `{"status": "draft"}`, wantErr: domain.ErrForbidden},
+			{name: "Editor can't schedule", ctx: movieEditor, patch: `{"unpublishAt": "2030-01-01T00:00:00Z"}`, wantErr: domain.ErrForbidden},
+			{name: "Publisher unpublishes", ctx: moviePublisher, patch: `{"status": "draft"}`},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+				movieRepo.EXPECT().GetByID(id).Return(stored, nil)
+				if tt.wantErr == nil {
+					movieRepo.EXPECT().Patch(id, gomock.Any(), gomock.Any(), 3).DoAndReturn(
+						func(_ primitive.ObjectID, movie *domain.Movie, _ []string, _ int) (*domain.Movie, error) {
+							return movie, nil
+						})
+				}
+
+				_, err := newService(movieRepo).Patch(tt.ctx, id, []byte(tt.patch), 0)
+				if tt.wantErr != nil {
+					assert.ErrorIs(t, err, tt.wantErr)
+					return
+				}
+
+				assert.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		movieService := newService(movieRepo)
+
+		assert.ErrorIs(t, movieService.Delete(movieEditor, id, 0), domain.ErrForbidden)
+
+		movieRepo.EXPECT().GetByID(id).Return(stored, nil)
+		movieRepo.EXPECT().Delete(id, 0, "movie-publisher").Return(nil)
+		assert.NoError(t, movieService.Delete(moviePublisher, id, 0))
+	})
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"events/internal/domain"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"reflect"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// unauditedFields change with every write and would only repeat what the
+// audit entry itself records.
+var unauditedFields = map[string]bool{
+	"_id":        true,
+	"version":    true,
+	"createdAt":  true,
+	"updatedAt":  true,
+	"createdBy":  true,
+	"updatedBy":  true,
+	"popularity": true,
+}
+
+// record appends a change to the audit log. The change has already been
+// made, so a failure to record it is logged rather than returned.
+func (s *EventService[T, PT]) record(ctx context.Context, action domain.AuditAction, id primitive.ObjectID, before, after *T) {
+	eventType := PT(new(T)).Kind().Type
+
+	changes, err := diffFields(before, after)
+	if err != nil {
+		slog.Error("Error computing audit changes: ", slog.String("eventType", string(eventType)), utils.Err(err))
+		return
+	}
+
+	entry := &domain.AuditEntry{
+		EntityType: eventType,
+		EntityID:   id,
+		Action:     action,
+		Actor:      domain.ActorFrom(ctx),
+		At:         time.Now().UTC(),
+		Changes:    changes,
+	}
+
+	if err := s.AuditRepository.Record(entry); err != nil {
+		slog.Error("Error recording audit entry: ", slog.String("eventType", string(eventType)), utils.Err(err))
+	}
+}
+
+// diffFields compares the JSON forms of before and after field by field. A
+// nil side stands for an event that does not exist.
+func diffFields(before, after interface{}) ([]domain.FieldChange, error) {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := jsonFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(beforeFields)+len(afterFields))
+	for name := range beforeFields {
+		names = append(names, name)
+	}
+	for name := range afterFields {
+		if _, ok := beforeFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	changes := []domain.FieldChange{}
+	for _, name := range names {
+		if unauditedFields[name] || reflect.DeepEqual(beforeFields[name], afterFields[name]) {
+			continue
+		}
+		changes = append(changes, domain.FieldChange{Field: name, Before: beforeFields[name], After: afterFields[name]})
+	}
+
+	return changes, nil
+}
+
+func jsonFields(v interface{}) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	if v == nil || reflect.ValueOf(v).IsNil() {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
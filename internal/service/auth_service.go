@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	repository "events/internal/repository/interfaces"
+	"events/pkg/lib/jwt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// apiKeyPrefix marks the API keys this service issues, so that leaked
+	// keys are easy to search for.
+	apiKeyPrefix    = "evk_"
+	apiKeyShownSize = len(apiKeyPrefix) + 6
+)
+
+// AuthService identifies callers by JWT bearer tokens or API keys, along
+// with their roles, and manages API keys and role assignments.
+type AuthService struct {
+	Verifier         *jwt.Verifier
+	APIKeyRepository repository.APIKeyRepository
+	RoleRepository   repository.RoleRepository
+	// Admins are subjects that are catalog-wide admins without an
+	// assignment, so that the first assignments can be made.
+	Admins []string
+}
+
+// NewAuthService returns a service that accepts tokens checked by verifier,
+// or no tokens at all when verifier is nil.
+func NewAuthService(verifier *jwt.Verifier, apiKeyRepository repository.APIKeyRepository, roleRepository repository.RoleRepository, admins []string) *AuthService {
+	return &AuthService{
+		Verifier:         verifier,
+		APIKeyRepository: apiKeyRepository,
+		RoleRepository:   roleRepository,
+		Admins:           admins,
+	}
+}
+
+func (s *AuthService) AuthenticateToken(token string) (*domain.Principal, error) {
+	if s.Verifier == nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	claims, err := s.Verifier.Verify(token)
+	if err != nil {
+		slog.Debug("rejected bearer token", slog.String("reason", err.Error()))
+		return nil, domain.ErrInvalidCredentials
+	}
+	if claims.Subject == "" {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	return s.withRoles(&domain.Principal{
+		Subject: claims.Subject,
+		Name:    claims.Name,
+		Method:  domain.AuthToken,
+	})
+}
+
+func (s *AuthService) AuthenticateAPIKey(key string) (*domain.Principal, error) {
+	apiKey, err := s.APIKeyRepository.GetByHash(hashSecret(key))
+	if errors.Is(err, domain.ErrAPIKeyNotFound) {
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withRoles(&domain.Principal{
+		Subject: "apikey:" + apiKey.ID.Hex(),
+		Name:    apiKey.Name,
+		Method:  domain.AuthAPIKey,
+	})
+}
+
+func (s *AuthService) withRoles(principal *domain.Principal) (*domain.Principal, error) {
+	assignments, err := s.RoleRepository.GetBySubject(principal.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	principal.Roles = []domain.RoleAssignment{}
+	if slices.Contains(s.Admins, principal.Subject) {
+		principal.Roles = append(principal.Roles, domain.RoleAssignment{Subject: principal.Subject, Role: domain.RoleAdmin})
+	}
+	for _, assignment := range assignments {
+		principal.Roles = append(principal.Roles, *assignment)
+	}
+
+	return principal, nil
+}
+
+// CreateAPIKey issues a new key. The response is the only place the key
+// appears in plain text.
+func (s *AuthService) CreateAPIKey(ctx context.Context, request *domain.CreateAPIKeyRequest) (*domain.CreateAPIKeyResponse, error) {
+	if err := domain.Authorize(ctx, domain.PermissionManage, ""); err != nil {
+		return nil, err
+	}
+
+	key, err := newSecret(apiKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := s.APIKeyRepository.Create(&domain.APIKey{
+		Name:      request.Name,
+		Prefix:    key[:apiKeyShownSize],
+		Hash:      hashSecret(key),
+		CreatedAt: time.Now().UTC(),
+		CreatedBy: domain.ActorFrom(ctx),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.CreateAPIKeyResponse{
+		APIKey: *apiKey,
+		Key:    key,
+	}, nil
+}
+
+func (s *AuthService) GetAPIKeys() ([]*domain.APIKey, error) {
+	return s.APIKeyRepository.GetAll()
+}
+
+func (s *AuthService) RevokeAPIKey(ctx context.Context, id primitive.ObjectID) error {
+	if err := domain.Authorize(ctx, domain.PermissionManage, ""); err != nil {
+		return err
+	}
+
+	return s.APIKeyRepository.Revoke(id)
+}
+
+func (s *AuthService) GetRoleAssignments(page, pageSize int) ([]*domain.RoleAssignment, error) {
+	return s.RoleRepository.GetAll(page, pageSize)
+}
+
+func (s *AuthService) GetRoleAssignmentCount() (int, error) {
+	return s.RoleRepository.GetTotalCount()
+}
+
+// AssignRole grants a role to a subject, who holds it from their next
+// request on.
+func (s *AuthService) AssignRole(ctx context.Context, assignment *domain.RoleAssignment) (*domain.RoleAssignment, error) {
+	if err := domain.Authorize(ctx, domain.PermissionManage, ""); err != nil {
+		return nil, err
+	}
+
+	assignment.Subject = strings.TrimSpace(assignment.Subject)
+	if assignment.Subject == "" || !assignment.Role.IsValid() {
+		return nil, domain.ErrInvalidRole
+	}
+	for _, eventType := range assignment.EventTypes {
+		if !eventType.IsValid() {
+			return nil, domain.ErrInvalidRole
+		}
+	}
+	slices.Sort(assignment.EventTypes)
+	assignment.EventTypes = slices.Compact(assignment.EventTypes)
+
+	assignment.AssignedAt = time.Now().UTC()
+	assignment.AssignedBy = domain.ActorFrom(ctx)
+
+	return s.RoleRepository.Create(assignment)
+}
+
+func (s *AuthService) RevokeRole(ctx context.Context, id primitive.ObjectID) error {
+	if err := domain.Authorize(ctx, domain.PermissionManage, ""); err != nil {
+		return err
+	}
+
+	return s.RoleRepository.Delete(id)
+}
@@ -0,0 +1,155 @@
+package service_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"events/internal/domain"
+	mock_repository "events/internal/repository/mocks"
+	"events/internal/service"
+)
+
+func TestAPIKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	apiKeyRepo := mock_repository.NewMockAPIKeyRepository(ctrl)
+	roleRepo := mock_repository.NewMockRoleRepository(ctrl)
+	authService := service.NewAuthService(nil, apiKeyRepo, roleRepo, nil)
+
+	keyID := primitive.NewObjectID()
+	var stored *domain.APIKey
+	apiKeyRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(key *domain.APIKey) (*domain.APIKey, error) {
+		key.ID = keyID
+		stored = key
+		return key, nil
+	})
+
+	ctx := callerContext("admin-1", domain.RoleAdmin)
+	created, err := authService.CreateAPIKey(ctx, &domain.CreateAPIKeyRequest{Name: "box office"})
+	require.NoError(t, err)
+
+	t.Run("Only the hash is stored", func(t *testing.T) {
+		sum := sha256.Sum256([]byte(created.Key))
+		assert.True(t, strings.HasPrefix(created.Key, "evk_"))
+		assert.True(t, strings.HasPrefix(created.Key, stored.Prefix))
+		assert.Equal(t, hex.EncodeToString(sum[:]), stored.Hash)
+		assert.NotContains(t, stored.Hash, created.Key)
+		assert.Equal(t, "admin-1", stored.CreatedBy)
+	})
+
+	t.Run("Authenticate with the key", func(t *testing.T) {
+		subject := "apikey:" + keyID.Hex()
+		assignment := &domain.RoleAssignment{Subject: subject, Role: domain.RoleViewer}
+		apiKeyRepo.EXPECT().GetByHash(stored.Hash).Return(stored, nil)
+		roleRepo.EXPECT().GetBySubject(subject).Return([]*domain.RoleAssignment{assignment}, nil)
+
+		principal, err := authService.AuthenticateAPIKey(created.Key)
+		require.NoError(t, err)
+		assert.Equal(t, &domain.Principal{
+			Subject: subject,
+			Name:    "box office",
+			Method:  domain.AuthAPIKey,
+			Roles:   []domain.RoleAssignment{*assignment},
+		}, principal)
+	})
+
+	t.Run("Unknown or revoked key", func(t *testing.T) {
+		apiKeyRepo.EXPECT().GetByHash(gomock.Any()).Return(nil, domain.ErrAPIKeyNotFound)
+
+		_, err := authService.AuthenticateAPIKey("evk_unknown")
+		assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	t.Run("Only admins create keys", func(t *testing.T) {
+		_, err := authService.CreateAPIKey(callerContext("editor-1", domain.RoleEditor), &domain.CreateAPIKeyRequest{Name: "scraper"})
+		assert.ErrorIs(t, err, domain.ErrForbidden)
+	})
+
+	t.Run("Tokens without configured keys", func(t *testing.T) {
+		_, err := authService.AuthenticateToken("a.b.c")
+		assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+}
+
+func TestAssignRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name       string
+		ctx        context.Context
+		assignment domain.RoleAssignment
+		wantErr    error
+	}{
+		{
+			name:       "Per event type",
+			ctx:        callerContext("admin-1", domain.RoleAdmin),
+			assignment: domain.RoleAssignment{Subject: " editor-1 ", Role: domain.RoleEditor, EventTypes: []domain.EventType{"performance", "movie", "movie"}},
+		},
+		{
+			name:       "Unknown role",
+			ctx:        callerContext("admin-1", domain.RoleAdmin),
+			assignment: domain.RoleAssignment{Subject: "editor-1", Role: "owner"},
+			wantErr:    domain.ErrInvalidRole,
+		},
+		{
+			name:       "Unknown event type",
+			ctx:        callerContext("admin-1", domain.RoleAdmin),
+			assignment: domain.RoleAssignment{Subject: "editor-1", Role: domain.RoleEditor, EventTypes: []domain.EventType{"opera"}},
+			wantErr:    domain.ErrInvalidRole,
+		},
+		{
+			name:       "Admin of one event type",
+			ctx:        callerContext("admin-2", domain.RoleAdmin, domain.EventTypeMovie),
+			assignment: domain.RoleAssignment{Subject: "editor-1", Role: domain.RoleEditor},
+			wantErr:    domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepo := mock_repository.NewMockRoleRepository(ctrl)
+			if tt.wantErr == nil {
+				roleRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(assignment *domain.RoleAssignment) (*domain.RoleAssignment, error) {
+					return assignment, nil
+				})
+			}
+
+			authService := service.NewAuthService(nil, nil, roleRepo, nil)
+
+			assignment := tt.assignment
+			created, err := authService.AssignRole(tt.ctx, &assignment)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "editor-1", created.Subject)
+			assert.Equal(t, []domain.EventType{domain.EventTypeMovie, domain.EventTypePerformance}, created.EventTypes)
+			assert.Equal(t, "admin-1", created.AssignedBy)
+		})
+	}
+
+	t.Run("Configured admins", func(t *testing.T) {
+		apiKeyRepo := mock_repository.NewMockAPIKeyRepository(ctrl)
+		roleRepo := mock_repository.NewMockRoleRepository(ctrl)
+		authService := service.NewAuthService(nil, apiKeyRepo, roleRepo, []string{"apikey:" + primitive.NilObjectID.Hex()})
+
+		apiKeyRepo.EXPECT().GetByHash(gomock.Any()).Return(&domain.APIKey{Name: "bootstrap"}, nil)
+		roleRepo.EXPECT().GetBySubject(gomock.Any()).Return([]*domain.RoleAssignment{}, nil)
+
+		principal, err := authService.AuthenticateAPIKey("evk_bootstrap")
+		require.NoError(t, err)
+		assert.True(t, principal.Can(domain.PermissionManage, ""))
+	})
+}
@@ -0,0 +1,263 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base32"
+	"events/internal/domain"
+	repository "events/internal/repository/interfaces"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	maxSeatsPerBooking = 10
+	bookingTokenPrefix = "evb_"
+)
+
+type BookingService struct {
+	BookingRepository  repository.BookingRepository
+	ShowtimeRepository repository.ShowtimeRepository
+	HallRepository     repository.HallRepository
+	Events             repository.EventCatalog
+	HoldTTL            time.Duration
+}
+
+func NewBookingService(bookingRepository repository.BookingRepository, showtimeRepository repository.ShowtimeRepository, hallRepository repository.HallRepository, events repository.EventCatalog, holdTTL time.Duration) *BookingService {
+	return &BookingService{
+		BookingRepository:  bookingRepository,
+		ShowtimeRepository: showtimeRepository,
+		HallRepository:     hallRepository,
+		Events:             events,
+		HoldTTL:            holdTTL,
+	}
+}
+
+func (s *BookingService) GetSeatAvailability(showtimeID primitive.ObjectID) (*domain.SeatAvailability, error) {
+	showtime, hall, err := s.showtimeWithHall(showtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	taken, err := s.BookingRepository.GetTakenSeats(showtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SeatAvailability{
+		ShowtimeID:     showtime.ID,
+		HallID:         hall.ID,
+		Capacity:       hall.Capacity,
+		SeatCategories: hall.SeatCategories,
+		PriceTiers:     showtime.PriceTiers,
+		SeatMap:        hall.SeatMap,
+		TakenSeats:     taken,
+	}, nil
+}
+
+func (s *BookingService) GetBookingByID(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error) {
+	booking, err := s.bookingWithToken(id, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// Holds are released lazily, report them as expired as soon as the TTL passed.
+	if booking.Status == domain.BookingHeld && booking.ExpiresAt != nil && booking.ExpiresAt.Before(time.Now()) {
+		booking.Status = domain.BookingExpired
+	}
+
+	return booking, nil
+}
+
+func (s *BookingService) HoldSeats(request *domain.CreateBookingRequest) (*domain.CreateBookingResponse, error) {
+	if len(request.Seats) == 0 || len(request.Seats) > maxSeatsPerBooking {
+		return nil, domain.ErrInvalidSeats
+	}
+
+	showtime, hall, err := s.showtimeWithHall(request.ShowtimeID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	if showtime.Status != domain.ShowtimeScheduled || !showtime.StartTime.After(now) {
+		return nil, domain.ErrShowtimeNotBookable
+	}
+
+	onSale, err := s.eventOnSale(showtime)
+	if err != nil {
+		return nil, err
+	}
+	if !onSale {
+		return nil, domain.ErrShowtimeNotBookable
+	}
+
+	prices := make(map[string]domain.PriceTier, len(showtime.PriceTiers))
+	for _, tier := range showtime.PriceTiers {
+		prices[tier.Category] = tier
+	}
+
+	hallSeats := hall.SeatMap.Seats()
+	selected := make(map[string]bool, len(request.Seats))
+
+	seats := make([]domain.BookingSeat, 0, len(request.Seats))
+	total := 0.0
+	for _, key := range request.Seats {
+		seat, ok := hallSeats[key]
+		if !ok || selected[key] {
+			return nil, domain.ErrInvalidSeats
+		}
+		selected[key] = true
+
+		tier, ok := prices[seat.Category]
+		if !ok {
+			return nil, domain.ErrInvalidSeats
+		}
+
+		seats = append(seats, domain.BookingSeat{
+			Seat:     key,
+			Category: seat.Category,
+			Price:    tier.Price,
+			Currency: tier.Currency,
+		})
+		total += tier.Price
+	}
+
+	token, err := newSecret(bookingTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := now.Add(s.HoldTTL)
+
+	booking := &domain.CreateBookingResponse{
+		ShowtimeID:      showtime.ID,
+		Seats:           seats,
+		Customer:        request.Customer,
+		Status:          domain.BookingHeld,
+		Total:           total,
+		CreatedAt:       now,
+		ExpiresAt:       &expiresAt,
+		AccessTokenHash: hashSecret(token),
+	}
+
+	created, err := s.BookingRepository.HoldSeats(booking)
+	if err != nil {
+		return nil, err
+	}
+
+	created.AccessToken = token
+
+	return created, nil
+}
+
+func (s *BookingService) ConfirmBooking(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error) {
+	if _, err := s.bookingWithToken(id, token); err != nil {
+		return nil, err
+	}
+
+	ticketCode, err := newTicketCode()
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := s.BookingRepository.ConfirmBooking(id, ticketCode)
+	if err != nil {
+		return nil, err
+	}
+
+	s.addPopularity(booking)
+
+	return booking, nil
+}
+
+func (s *BookingService) CancelBooking(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error) {
+	if _, err := s.bookingWithToken(id, token); err != nil {
+		return nil, err
+	}
+
+	return s.BookingRepository.CancelBooking(id)
+}
+
+// bookingWithToken returns the booking if token is its access token. A wrong
+// token is reported like a missing booking, so booking IDs can't be probed.
+func (s *BookingService) bookingWithToken(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error) {
+	booking, err := s.BookingRepository.GetBookingByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if booking == nil || booking.AccessTokenHash == "" ||
+		subtle.ConstantTimeCompare([]byte(hashSecret(token)), []byte(booking.AccessTokenHash)) != 1 {
+		return nil, domain.ErrBookingNotFound
+	}
+
+	return booking, nil
+}
+
+func (s *BookingService) showtimeWithHall(showtimeID primitive.ObjectID) (*domain.GetShowtimeResponse, *domain.GetHallResponse, error) {
+	showtime, err := s.ShowtimeRepository.GetShowtimeByID(showtimeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if showtime == nil {
+		return nil, nil, domain.ErrShowtimeNotFound
+	}
+
+	hall, err := s.HallRepository.GetHallByID(showtime.HallID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if hall == nil {
+		return nil, nil, domain.ErrHallNotFound
+	}
+
+	return showtime, hall, nil
+}
+
+// eventOnSale reports whether the event of the showtime is published and
+// outside the trash. Seats of events the public can't see can't be booked
+// either.
+func (s *BookingService) eventOnSale(showtime *domain.GetShowtimeResponse) (bool, error) {
+	events, ok := s.Events[showtime.EventType]
+	if !ok {
+		return false, nil
+	}
+
+	event, err := events.GetBaseByID(showtime.EventID)
+	if err != nil {
+		return false, err
+	}
+
+	return event != nil && event.Status == domain.StatusPublished, nil
+}
+
+// addPopularity credits the booked event with the sold seats, which ranks it
+// higher in suggestions. The booking stands even if this fails.
+func (s *BookingService) addPopularity(booking *domain.GetBookingResponse) {
+	showtime, err := s.ShowtimeRepository.GetShowtimeByID(booking.ShowtimeID)
+	if err != nil || showtime == nil {
+		return
+	}
+
+	events, ok := s.Events[showtime.EventType]
+	if !ok {
+		return
+	}
+
+	if err := events.AddPopularity(showtime.EventID, len(booking.Seats)); err != nil {
+		slog.Error("Error updating event popularity", utils.Err(err))
+	}
+}
+
+func newTicketCode() (string, error) {
+	code := make([]byte, 5)
+	if _, err := rand.Read(code); err != nil {
+		return "", err
+	}
+
+	return base32.StdEncoding.EncodeToString(code), nil
+}
@@ -0,0 +1,294 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"events/internal/domain"
+	repository "events/internal/repository/interfaces"
+	mock_repository "events/internal/repository/mocks"
+	"events/internal/service"
+)
+
+func TestHoldSeats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hall := &domain.GetHallResponse{
+		ID:             primitive.NewObjectID(),
+		SeatCategories: []domain.SeatCategory{{Code: "standard"}, {Code: "vip"}},
+		SeatMap: domain.SeatMap{Rows: []domain.SeatRow{
+			{Label: "A", Seats: []domain.Seat{{Number: "1", Category: "vip"}, {Number: "2", Category: "vip"}}},
+			{Label: "B", Seats: []domain.Seat{{Number: "1", Category: "standard"}}},
+		}},
+	}
+
+	newShowtime := func(status domain.ShowtimeStatus, startTime time.Time) *domain.GetShowtimeResponse {
+		return &domain.GetShowtimeResponse{
+			ID:        primitive.NewObjectID(),
+			EventID:   primitive.NewObjectID(),
+			EventType: domain.EventTypeMovie,
+			HallID:    hall.ID,
+			StartTime: startTime,
+			Status:    status,
+			PriceTiers: []domain.PriceTier{
+				{Category: "standard", Price: 50, Currency: "TMT"},
+				{Category: "vip", Price: 80, Currency: "TMT"},
+			},
+		}
+	}
+
+	published := &domain.EventBase{Status: domain.StatusPublished}
+
+	tests := []struct {
+		name      string
+		showtime  *domain.GetShowtimeResponse
+		event     *domain.EventBase
+		seats     []string
+		wantTotal float64
+		wantErr   error
+	}{
+		{
+			name:      "Seats priced by category",
+			showtime:  newShowtime(domain.ShowtimeScheduled, time.Now().Add(time.Hour)),
+			event:     published,
+			seats:     []string{"A-1", "B-1"},
+			wantTotal: 130,
+		},
+		{
+			name:     "Unknown seat",
+			showtime: newShowtime(domain.ShowtimeScheduled, time.Now().Add(time.Hour)),
+			event:    published,
+			seats:    []string{"C-1"},
+			wantErr:  domain.ErrInvalidSeats,
+		},
+		{
+			name:     "Same seat twice",
+			showtime: newShowtime(domain.ShowtimeScheduled, time.Now().Add(time.Hour)),
+			event:    published,
+			seats:    []string{"A-1", "A-1"},
+			wantErr:  domain.ErrInvalidSeats,
+		},
+		{
+			name:     "Showtime already started",
+			showtime: newShowtime(domain.ShowtimeScheduled, time.Now().Add(-time.Minute)),
+			event:    published,
+			seats:    []string{"A-1"},
+			wantErr:  domain.ErrShowtimeNotBookable,
+		},
+		{
+			name:     "Cancelled showtime",
+			showtime: newShowtime(domain.ShowtimeCancelled, time.Now().Add(time.Hour)),
+			event:    published,
+			seats:    []string{"A-1"},
+			wantErr:  domain.ErrShowtimeNotBookable,
+		},
+		{
+			name:     "Event in the trash",
+			showtime: newShowtime(domain.ShowtimeScheduled, time.Now().Add(time.Hour)),
+			event:    nil,
+			seats:    []string{"A-1"},
+			wantErr:  domain.ErrShowtimeNotBookable,
+		},
+		{
+			name:     "Unpublished event",
+			showtime: newShowtime(domain.ShowtimeScheduled, time.Now().Add(time.Hour)),
+			event:    &domain.EventBase{Status: domain.StatusDraft},
+			seats:    []string{"A-1"},
+			wantErr:  domain.ErrShowtimeNotBookable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookingRepo := mock_repository.NewMockBookingRepository(ctrl)
+			showtimeRepo := mock_repository.NewMockShowtimeRepository(ctrl)
+			hallRepo := mock_repository.NewMockHallRepository(ctrl)
+			movieRepo := mock_repository.NewMockEventFinder(ctrl)
+
+			showtimeRepo.EXPECT().GetShowtimeByID(tt.showtime.ID).Return(tt.showtime, nil)
+			hallRepo.EXPECT().GetHallByID(hall.ID).Return(hall, nil)
+			movieRepo.EXPECT().GetBaseByID(tt.showtime.EventID).Return(tt.event, nil).AnyTimes()
+			if tt.wantErr == nil {
+				bookingRepo.EXPECT().HoldSeats(gomock.Any()).DoAndReturn(
+					func(booking *domain.CreateBookingResponse) (*domain.CreateBookingResponse, error) {
+						booking.ID = primitive.NewObjectID()
+						return booking, nil
+					})
+			}
+
+			bookingService := service.NewBookingService(bookingRepo, showtimeRepo, hallRepo, repository.EventCatalog{
+				domain.EventTypeMovie: movieRepo,
+			}, 10*time.Minute)
+
+			got, err := bookingService.HoldSeats(&domain.CreateBookingRequest{
+				ShowtimeID: tt.showtime.ID,
+				Seats:      tt.seats,
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, domain.BookingHeld, got.Status)
+			assert.Equal(t, tt.wantTotal, got.Total)
+			assert.WithinDuration(t, time.Now().Add(10*time.Minute), *got.ExpiresAt, time.Second)
+			assert.True(t, strings.HasPrefix(got.AccessToken, "evb_"))
+			assert.NotEmpty(t, got.AccessTokenHash)
+		})
+	}
+}
+
+func TestConfirmBookingCreditsEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bookingRepo := mock_repository.NewMockBookingRepository(ctrl)
+	showtimeRepo := mock_repository.NewMockShowtimeRepository(ctrl)
+	hallRepo := mock_repository.NewMockHallRepository(ctrl)
+	movieRepo := mock_repository.NewMockEventFinder(ctrl)
+
+	showtime := &domain.GetShowtimeResponse{
+		ID:        primitive.NewObjectID(),
+		EventID:   primitive.NewObjectID(),
+		EventType: domain.EventTypeMovie,
+	}
+	booking := &domain.GetBookingResponse{
+		ID:         primitive.NewObjectID(),
+		ShowtimeID: showtime.ID,
+		Seats:      []domain.BookingSeat{{Seat: "A-1"}, {Seat: "A-2"}},
+		Status:     domain.BookingConfirmed,
+	}
+
+	token, tokenHash := holdToken(t, ctrl)
+	booking.AccessTokenHash = tokenHash
+
+	bookingRepo.EXPECT().GetBookingByID(booking.ID).Return(&domain.GetBookingResponse{ID: booking.ID, AccessTokenHash: tokenHash}, nil)
+	bookingRepo.EXPECT().ConfirmBooking(booking.ID, gomock.Any()).Return(booking, nil)
+	showtimeRepo.EXPECT().GetShowtimeByID(showtime.ID).Return(showtime, nil)
+	movieRepo.EXPECT().AddPopularity(showtime.EventID, 2).Return(nil)
+
+	bookingService := service.NewBookingService(bookingRepo, showtimeRepo, hallRepo, repository.EventCatalog{
+		domain.EventTypeMovie: movieRepo,
+	}, 10*time.Minute)
+
+	got, err := bookingService.ConfirmBooking(booking.ID, token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, booking, got)
+}
+
+func TestBookingAccessToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	token, tokenHash := holdToken(t, ctrl)
+	_, otherHash := holdToken(t, ctrl)
+
+	id := primitive.NewObjectID()
+
+	tests := []struct {
+		name    string
+		booking *domain.GetBookingResponse
+		token   string
+		wantErr error
+	}{
+		{
+			name:    "Token of the booking",
+			booking: &domain.GetBookingResponse{ID: id, Status: domain.BookingHeld, AccessTokenHash: tokenHash},
+			token:   token,
+		},
+		{
+			name:    "Token of another booking",
+			booking: &domain.GetBookingResponse{ID: id, Status: domain.BookingHeld, AccessTokenHash: otherHash},
+			token:   token,
+			wantErr: domain.ErrBookingNotFound,
+		},
+		{
+			name:    "Booking without a token",
+			booking: &domain.GetBookingResponse{ID: id, Status: domain.BookingHeld},
+			token:   "",
+			wantErr: domain.ErrBookingNotFound,
+		},
+		{
+			name:    "Missing booking",
+			token:   token,
+			wantErr: domain.ErrBookingNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookingRepo := mock_repository.NewMockBookingRepository(ctrl)
+			bookingService := service.NewBookingService(bookingRepo, nil, nil, nil, 10*time.Minute)
+
+			bookingRepo.EXPECT().GetBookingByID(id).Return(tt.booking, nil).Times(2)
+			if tt.wantErr == nil {
+				bookingRepo.EXPECT().CancelBooking(id).Return(tt.booking, nil)
+			}
+
+			got, err := bookingService.GetBookingByID(id, tt.token)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr == nil {
+				assert.Equal(t, tt.booking, got)
+			}
+
+			_, err = bookingService.CancelBooking(id, tt.token)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+// holdToken holds a seat and returns the access token issued for the booking
+// along with the hash the booking was stored with.
+func holdToken(t *testing.T, ctrl *gomock.Controller) (string, string) {
+	t.Helper()
+
+	hall := &domain.GetHallResponse{
+		ID:             primitive.NewObjectID(),
+		SeatCategories: []domain.SeatCategory{{Code: "standard"}},
+		SeatMap: domain.SeatMap{Rows: []domain.SeatRow{
+			{Label: "A", Seats: []domain.Seat{{Number: "1", Category: "standard"}}},
+		}},
+	}
+	showtime := &domain.GetShowtimeResponse{
+		ID:         primitive.NewObjectID(),
+		EventID:    primitive.NewObjectID(),
+		EventType:  domain.EventTypeMovie,
+		HallID:     hall.ID,
+		StartTime:  time.Now().Add(time.Hour),
+		Status:     domain.ShowtimeScheduled,
+		PriceTiers: []domain.PriceTier{{Category: "standard", Price: 50, Currency: "TMT"}},
+	}
+
+	bookingRepo := mock_repository.NewMockBookingRepository(ctrl)
+	showtimeRepo := mock_repository.NewMockShowtimeRepository(ctrl)
+	hallRepo := mock_repository.NewMockHallRepository(ctrl)
+	movieRepo := mock_repository.NewMockEventFinder(ctrl)
+
+	showtimeRepo.EXPECT().GetShowtimeByID(showtime.ID).Return(showtime, nil)
+	hallRepo.EXPECT().GetHallByID(hall.ID).Return(hall, nil)
+	movieRepo.EXPECT().GetBaseByID(showtime.EventID).Return(&domain.EventBase{Status: domain.StatusPublished}, nil)
+	bookingRepo.EXPECT().HoldSeats(gomock.Any()).DoAndReturn(
+		func(booking *domain.CreateBookingResponse) (*domain.CreateBookingResponse, error) {
+			booking.ID = primitive.NewObjectID()
+			return booking, nil
+		})
+
+	bookingService := service.NewBookingService(bookingRepo, showtimeRepo, hallRepo, repository.EventCatalog{
+		domain.EventTypeMovie: movieRepo,
+	}, 10*time.Minute)
+
+	booking, err := bookingService.HoldSeats(&domain.CreateBookingRequest{ShowtimeID: showtime.ID, Seats: []string{"A-1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return booking.AccessToken, booking.AccessTokenHash
+}
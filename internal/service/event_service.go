@@ -0,0 +1,521 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"events/internal/domain"
+	repository "events/internal/repository/interfaces"
+	"events/pkg/lib/mergepatch"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EventService validates event writes, checks the caller of the request
+// context may make them, attributes them to the caller and records every
+// change in the audit log. The version an update replaces is kept as a
+// revision.
+type EventService[T any, PT domain.EventPtr[T]] struct {
+	EventRepository    repository.EventRepository[T]
+	AuditRepository    repository.AuditRepository
+	RevisionRepository repository.RevisionRepository[T]
+	ShowtimeRepository repository.ShowtimeRepository
+}
+
+func NewEventService[T any, PT domain.EventPtr[T]](eventRepository repository.EventRepository[T], auditRepository repository.AuditRepository, revisionRepository repository.RevisionRepository[T], showtimeRepository repository.ShowtimeRepository) *EventService[T, PT] {
+	return &EventService[T, PT]{
+		EventRepository:    eventRepository,
+		AuditRepository:    auditRepository,
+		RevisionRepository: revisionRepository,
+		ShowtimeRepository: showtimeRepository,
+	}
+}
+
+func (s *EventService[T, PT]) GetAll(filter domain.EventFilter, sort domain.Sort, page, pageSize int) ([]*T, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EventRepository.GetAll(filter, sort, page, pageSize)
+}
+
+func (s *EventService[T, PT]) GetTotalCount(filter domain.EventFilter) (int, error) {
+	if err := filter.Validate(); err != nil {
+		return 0, err
+	}
+
+	return s.EventRepository.GetTotalCount(filter)
+}
+
+func (s *EventService[T, PT]) GetSearchCount(query string) (int, error) {
+	return s.EventRepository.GetSearchCount(query)
+}
+
+func (s *EventService[T, PT]) GetFilteredCount(tags []string) (int, error) {
+	return s.EventRepository.GetFilteredCount(tags)
+}
+
+func (s *EventService[T, PT]) GetSearchFacets(query string) (*domain.Facets, error) {
+	return s.EventRepository.GetSearchFacets(query)
+}
+
+func (s *EventService[T, PT]) GetFilteredFacets(tags []string) (*domain.Facets, error) {
+	return s.EventRepository.GetFilteredFacets(tags)
+}
+
+func (s *EventService[T, PT]) GetByID(id primitive.ObjectID) (*T, error) {
+	return s.EventRepository.GetByID(id)
+}
+
+// Create stores a new event. Without a status it is scheduled when its
+// publishAt lies ahead and published otherwise, or kept in draft when the
+// caller can't publish.
+func (s *EventService[T, PT]) Create(ctx context.Context, event *T) (*T, error) {
+	if err := s.authorize(ctx, domain.PermissionWrite); err != nil {
+		return nil, err
+	}
+
+	if PT(event).Base().Status == "" && s.authorize(ctx, domain.PermissionPublish) != nil {
+		PT(event).Base().Status = domain.StatusDraft
+	}
+
+	if err := checkEvent(PT(event), "", time.Now()); err != nil {
+		return nil, err
+	}
+
+	if PT(event).Base().Status != domain.StatusDraft {
+		if err := s.authorize(ctx, domain.PermissionPublish); err != nil {
+			return nil, err
+		}
+	}
+
+	base := PT(event).Base()
+	base.CreatedBy = domain.ActorFrom(ctx)
+	base.UpdatedBy = base.CreatedBy
+
+	created, err := s.EventRepository.Create(event)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, domain.AuditCreate, PT(created).Base().ID, nil, created)
+
+	return created, nil
+}
+
+// Update replaces the event. A non-zero version must match the stored one.
+// The write is conditional on the version saved as a revision, so a
+// concurrent edit fails with ErrVersionMismatch instead of going unsaved.
+func (s *EventService[T, PT]) Update(ctx context.Context, id primitive.ObjectID, event *T, version int) (*T, error) {
+	if err := s.authorize(ctx, domain.PermissionWrite); err != nil {
+		return nil, err
+	}
+
+	before, err := s.getExisting(id)
+	if err != nil {
+		return nil, err
+	}
+
+	currentVersion := PT(before).Base().Version
+	if version != 0 && version != currentVersion {
+		return nil, domain.ErrVersionMismatch
+	}
+
+	if err := s.authorizePublication(ctx, PT(before).Base(), PT(event).Base()); err != nil {
+		return nil, err
+	}
+
+	if err := checkEvent(PT(event), PT(before).Base().Status, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.RevisionRepository.Save(before); err != nil {
+		return nil, err
+	}
+
+	PT(event).Base().UpdatedBy = domain.ActorFrom(ctx)
+
+	updated, err := s.EventRepository.Update(id, event, currentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, domain.AuditUpdate, id, before, updated)
+
+	return updated, nil
+}
+
+// Patch applies a JSON merge patch to the stored event. The merged event is
+// validated as a whole, but only the fields named by the patch are written.
+// The write is conditional on the version the patch was merged into, so a
+// concurrent edit fails with ErrVersionMismatch instead of being mixed in.
+func (s *EventService[T, PT]) Patch(ctx context.Context, id primitive.ObjectID, patch []byte, version int) (*T, error) {
+	if err := s.authorize(ctx, domain.PermissionWrite); err != nil {
+		return nil, err
+	}
+
+	fields, err := mergepatch.Keys(patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPatch, err)
+	}
+
+	current, err := s.getExisting(id)
+	if err != nil {
+		return nil, err
+	}
+
+	currentVersion := PT(current).Base().Version
+	if version != 0 && version != currentVersion {
+		return nil, domain.ErrVersionMismatch
+	}
+
+	document, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := mergepatch.Apply(document, patch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPatch, err)
+	}
+
+	var event T
+	if err := json.Unmarshal(merged, &event); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidPatch, err)
+	}
+
+	if err := s.authorizePublication(ctx, PT(current).Base(), PT(&event).Base()); err != nil {
+		return nil, err
+	}
+
+	currentStatus := PT(current).Base().Status
+	if err := checkEvent(PT(&event), currentStatus, time.Now()); err != nil {
+		return nil, err
+	}
+	// The schedule may move the status on without the patch naming it.
+	if PT(&event).Base().Status != currentStatus && !slices.Contains(fields, "status") {
+		fields = append(fields, "status")
+	}
+
+	if err := s.RevisionRepository.Save(current); err != nil {
+		return nil, err
+	}
+
+	PT(&event).Base().UpdatedBy = domain.ActorFrom(ctx)
+
+	patched, err := s.EventRepository.Patch(id, &event, fields, currentVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, domain.AuditUpdate, id, current, patched)
+
+	return patched, nil
+}
+
+// Delete moves the event to the trash. A non-zero version must match the
+// stored one.
+func (s *EventService[T, PT]) Delete(ctx context.Context, id primitive.ObjectID, version int) error {
+	if err := s.authorize(ctx, domain.PermissionDelete); err != nil {
+		return err
+	}
+
+	before, err := s.getExisting(id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.EventRepository.Delete(id, version, domain.ActorFrom(ctx)); err != nil {
+		return err
+	}
+
+	s.record(ctx, domain.AuditDelete, id, before, nil)
+
+	return nil
+}
+
+// Restore takes a trashed event out of the trash.
+func (s *EventService[T, PT]) Restore(ctx context.Context, id primitive.ObjectID) (*T, error) {
+	if err := s.authorize(ctx, domain.PermissionDelete); err != nil {
+		return nil, err
+	}
+
+	restored, err := s.EventRepository.Restore(id, domain.ActorFrom(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.record(ctx, domain.AuditRestore, id, nil, restored)
+
+	return restored, nil
+}
+
+func (s *EventService[T, PT]) GetTrash(page, pageSize int) ([]*T, error) {
+	return s.EventRepository.GetTrash(page, pageSize)
+}
+
+func (s *EventService[T, PT]) GetTrashCount() (int, error) {
+	return s.EventRepository.GetTrashCount()
+}
+
+// PurgeTrash permanently removes the events that have been in the trash for
+// longer than retention, together with their revisions and showtimes, and
+// returns how many there were. Their history is kept and ends with a purge entry.
+func (s *EventService[T, PT]) PurgeTrash(retention time.Duration) (int, error) {
+	// Events purged before a failure are gone all the same, so they are
+	// cleaned up and recorded before the error is reported.
+	ids, purgeErr := s.EventRepository.Purge(time.Now().Add(-retention))
+	if len(ids) == 0 {
+		return 0, purgeErr
+	}
+
+	if err := s.RevisionRepository.DeleteRevisions(ids); err != nil {
+		return 0, err
+	}
+
+	if err := s.ShowtimeRepository.DeleteShowtimesByEvents(PT(new(T)).Kind().Type, ids); err != nil {
+		return 0, err
+	}
+
+	ctx := domain.WithActor(context.Background(), domain.SystemActor)
+	for _, id := range ids {
+		s.record(ctx, domain.AuditPurge, id, nil, nil)
+	}
+
+	return len(ids), purgeErr
+}
+
+// GetHistory lists the audit entries of the event, newest first. The
+// history outlives the event, so deleted events still have one.
+func (s *EventService[T, PT]) GetHistory(id primitive.ObjectID, page, pageSize int) ([]*domain.AuditEntry, error) {
+	return s.AuditRepository.GetHistory(PT(new(T)).Kind().Type, id, page, pageSize)
+}
+
+func (s *EventService[T, PT]) GetHistoryCount(id primitive.ObjectID) (int, error) {
+	return s.AuditRepository.GetHistoryCount(PT(new(T)).Kind().Type, id)
+}
+
+// GetRevisions lists the previous versions of the event, newest first. The
+// current version is not among them.
+func (s *EventService[T, PT]) GetRevisions(id primitive.ObjectID, page, pageSize int) ([]*domain.RevisionInfo, error) {
+	return s.RevisionRepository.GetRevisions(id, page, pageSize)
+}
+
+func (s *EventService[T, PT]) GetRevisionCount(id primitive.ObjectID) (int, error) {
+	return s.RevisionRepository.GetRevisionCount(id)
+}
+
+// GetRevision returns the event as it was at the given version, which may
+// be the current one.
+func (s *EventService[T, PT]) GetRevision(id primitive.ObjectID, number int) (*T, error) {
+	current, err := s.getExisting(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getRevision(current, number)
+}
+
+// DiffRevisions compares two versions of the event field by field. A zero
+// to compares against the current version.
+func (s *EventService[T, PT]) DiffRevisions(id primitive.ObjectID, from, to int) (*domain.RevisionDiff, error) {
+	current, err := s.getExisting(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if to == 0 {
+		to = PT(current).Base().Version
+	}
+
+	fromEvent, err := s.getRevision(current, from)
+	if err != nil {
+		return nil, err
+	}
+
+	toEvent, err := s.getRevision(current, to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := diffFields(fromEvent, toEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.RevisionDiff{From: from, To: to, Changes: changes}, nil
+}
+
+// RestoreRevision rolls the event back to the content it had at the given
+// version. The publication status and schedule are not content and stay as
+// they are. The rollback is an update like any other: it is validated,
+// checked against a non-zero version and saves the version it replaces.
+func (s *EventService[T, PT]) RestoreRevision(ctx context.Context, id primitive.ObjectID, number int, version int) (*T, error) {
+	current, err := s.getExisting(id)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := s.getRevision(current, number)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := *revision
+	restoredBase, currentBase := PT(&restored).Base(), PT(current).Base()
+	restoredBase.Status = currentBase.Status
+	restoredBase.PublishAt = currentBase.PublishAt
+	restoredBase.UnpublishAt = currentBase.UnpublishAt
+
+	return s.Update(ctx, id, &restored, version)
+}
+
+// ApplySchedule publishes the scheduled events and archives the published
+// events whose time has come at now, and returns how many changed. Each
+// change is an update by the system actor with a revision and an audit
+// entry. Events edited meanwhile are left for the next run.
+func (s *EventService[T, PT]) ApplySchedule(now time.Time) (int, error) {
+	due, err := s.EventRepository.GetScheduleDue(now)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx := domain.WithActor(context.Background(), domain.SystemActor)
+
+	changed := 0
+	for _, event := range due {
+		base := PT(event).Base()
+
+		next := *event
+		nextBase := PT(&next).Base()
+		nextBase.Status = base.ScheduledStatus(now)
+		nextBase.UpdatedBy = domain.SystemActor
+
+		if err := s.RevisionRepository.Save(event); err != nil {
+			return changed, err
+		}
+
+		updated, err := s.EventRepository.Patch(base.ID, &next, []string{"status"}, base.Version)
+		if errors.Is(err, domain.ErrVersionMismatch) || errors.Is(err, domain.ErrEventNotFound) {
+			continue
+		}
+		if err != nil {
+			return changed, err
+		}
+
+		s.record(ctx, domain.AuditUpdate, base.ID, event, updated)
+		changed++
+	}
+
+	return changed, nil
+}
+
+// authorize returns ErrForbidden unless the caller of ctx holds permission
+// for this event kind.
+func (s *EventService[T, PT]) authorize(ctx context.Context, permission domain.Permission) error {
+	return domain.Authorize(ctx, permission, PT(new(T)).Kind().Type)
+}
+
+// authorizePublication requires the publish permission for a write that
+// changes the status or schedule of an event. An omitted status keeps the
+// current one.
+func (s *EventService[T, PT]) authorizePublication(ctx context.Context, current, next *domain.EventBase) error {
+	if (next.Status == "" || next.Status == current.Status) &&
+		equalTimes(current.PublishAt, next.PublishAt) && equalTimes(current.UnpublishAt, next.UnpublishAt) {
+		return nil
+	}
+
+	return s.authorize(ctx, domain.PermissionPublish)
+}
+
+func equalTimes(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// checkEvent completes the status of an event being written and validates
+// the event. An omitted status keeps the previous one, for new events it
+// follows from publishAt. A status whose time has come moves on at once.
+func checkEvent(event domain.Event, previous domain.PublicationStatus, now time.Time) error {
+	base := event.Base()
+	if base.Status == "" {
+		switch {
+		case previous != "":
+			base.Status = previous
+		case base.PublishAt != nil && base.PublishAt.After(now):
+			base.Status = domain.StatusScheduled
+		default:
+			base.Status = domain.StatusPublished
+		}
+	}
+
+	if err := validateEvent(event, previous); err != nil {
+		return err
+	}
+
+	base.Status = base.ScheduledStatus(now)
+
+	return nil
+}
+
+func (s *EventService[T, PT]) getRevision(current *T, number int) (*T, error) {
+	base := PT(current).Base()
+	if number == base.Version {
+		return current, nil
+	}
+
+	revision, err := s.RevisionRepository.GetRevision(base.ID, number)
+	if err != nil {
+		return nil, err
+	}
+	if revision == nil {
+		return nil, domain.ErrRevisionNotFound
+	}
+
+	return revision, nil
+}
+
+func (s *EventService[T, PT]) getExisting(id primitive.ObjectID) (*T, error) {
+	event, err := s.EventRepository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, domain.ErrEventNotFound
+	}
+
+	return event, nil
+}
+
+func (s *EventService[T, PT]) Search(query string, sort domain.Sort, page int, pageSize int) ([]*T, error) {
+	return s.EventRepository.Search(query, sort, page, pageSize)
+}
+
+func (s *EventService[T, PT]) FilterByTags(tags []string, sort domain.Sort, page int, pageSize int) ([]*T, error) {
+	return s.EventRepository.FilterByTags(tags, sort, page, pageSize)
+}
+
+func (s *EventService[T, PT]) Suggest(query string, limit int) ([]*domain.Suggestion, error) {
+	return s.EventRepository.Suggest(query, limit)
+}
+
+func (s *EventService[T, PT]) GetAllAfter(filter domain.EventFilter, after primitive.ObjectID, pageSize int) ([]*T, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	return s.EventRepository.GetAllAfter(filter, after, pageSize)
+}
+
+func (s *EventService[T, PT]) SearchAfter(query string, after primitive.ObjectID, pageSize int) ([]*T, error) {
+	return s.EventRepository.SearchAfter(query, after, pageSize)
+}
+
+func (s *EventService[T, PT]) FilterByTagsAfter(tags []string, after primitive.ObjectID, pageSize int) ([]*T, error) {
+	return s.EventRepository.FilterByTagsAfter(tags, after, pageSize)
+}
@@ -0,0 +1,648 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"events/internal/domain"
+	mock_repository "events/internal/repository/mocks"
+	"events/internal/service"
+)
+
+func TestCreateEventValidation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	releaseDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		movie      *domain.Movie
+		wantFields []string
+	}{
+		{
+			name: "Valid movie",
+			movie: &domain.Movie{
+				EventBase: domain.EventBase{
+					Name:  "Dune: Part Two",
+					Cover: "https://cdn.example.com/dune.jpg",
+					Tags:  []string{"imax", "3d"},
+				},
+				ReleaseDate: releaseDate,
+			},
+		},
+		{
+			name:       "Missing name and release date",
+			movie:      &domain.Movie{},
+			wantFields: []string{"name", "releaseDate"},
+		},
+		{
+			name: "Duplicate tags and bad media",
+			movie: &domain.Movie{
+				EventBase: domain.EventBase{
+					Name:  "Dune: Part Two",
+					Tags:  []string{"imax", "imax"},
+					Media: []string{"javascript:alert(1)"},
+				},
+				ReleaseDate: releaseDate,
+			},
+			wantFields: []string{"tags[1]", "media[0]"},
+		},
+		{
+			name: "Release date out of range",
+			movie: &domain.Movie{
+				EventBase:   domain.EventBase{Name: "Dune: Part Two"},
+				ReleaseDate: time.Date(1024, 3, 1, 0, 0, 0, 0, time.UTC),
+			},
+			wantFields: []string{"releaseDate"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+			auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+			revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+			if tt.wantFields == nil {
+				movieRepo.EXPECT().Create(tt.movie).Return(tt.movie, nil)
+				auditRepo.EXPECT().Record(gomock.Any()).Return(nil)
+			}
+
+			movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+
+			_, err := movieService.Create(callerContext("admin", domain.RoleAdmin), tt.movie)
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *domain.ValidationError
+			if assert.True(t, errors.As(err, &validationErr)) {
+				fields := make([]string, 0, len(validationErr.Fields))
+				for _, field := range validationErr.Fields {
+					fields = append(fields, field.Field)
+				}
+				assert.Equal(t, tt.wantFields, fields)
+			}
+			assert.ErrorIs(t, err, domain.ErrValidation)
+		})
+	}
+}
+
+func TestCreateExhibitionValidation(t *testing.T) {
+	openFrom := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	exhibition := &domain.Exhibition{
+		EventBase: domain.EventBase{Name: "Carpets of Turkmenistan"},
+		OpenFrom:  openFrom,
+		OpenUntil: openFrom.AddDate(0, 0, -1),
+		OpeningHours: []domain.OpeningHours{
+			{Day: time.Monday, Opens: "10:00", Closes: "18:00"},
+			{Day: time.Monday, Opens: "10:00", Closes: "09:00"},
+			{Day: 9, Opens: "noon", Closes: "18:00"},
+		},
+	}
+
+	// Invalid events never reach the repository.
+	exhibitionService := service.NewEventService[domain.Exhibition](nil, nil, nil, nil)
+
+	_, err := exhibitionService.Create(callerContext("admin", domain.RoleAdmin), exhibition)
+
+	var validationErr *domain.ValidationError
+	if assert.True(t, errors.As(err, &validationErr)) {
+		assert.Equal(t, []domain.FieldError{
+			{Field: "openUntil", Message: "must not be before openFrom"},
+			{Field: "openingHours[1].day", Message: "is a duplicate"},
+			{Field: "openingHours[1].closes", Message: "must be after opens"},
+			{Field: "openingHours[2].day", Message: "must be a weekday from 0 (Sunday) to 6 (Saturday)"},
+			{Field: "openingHours[2].opens", Message: "must be a time in HH:MM format"},
+		}, validationErr.Fields)
+	}
+}
+
+func TestPatchEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := primitive.NewObjectID()
+	stored := &domain.Movie{
+		EventBase: domain.EventBase{
+			ID:       id,
+			Name:     "Dune",
+			Duration: 155,
+			Media:    []string{"https://cdn.example.com/dune-trailer.mp4"},
+			Status:   domain.StatusPublished,
+			Version:  3,
+		},
+		ReleaseDate: time.Date(2021, 10, 22, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name       string
+		patch      string
+		version    int
+		wantFields []string
+		wantEvent  func(*domain.Movie)
+		wantErr    error
+	}{
+		{
+			name:       "Omitted fields are kept",
+			patch:      `{"name": "Dune: Part One"}`,
+			wantFields: []string{"name"},
+			wantEvent: func(movie *domain.Movie) {
+				assert.Equal(t, "Dune: Part One", movie.Name)
+				assert.Equal(t, stored.Media, movie.Media)
+				assert.Equal(t, domain.Minutes(155), movie.Duration)
+			},
+		},
+		{
+			name:       "Null clears a field",
+			patch:      `{"media": null}`,
+			wantFields: []string{"media"},
+			wantEvent: func(movie *domain.Movie) {
+				assert.Nil(t, movie.Media)
+				assert.Equal(t, "Dune", movie.Name)
+			},
+		},
+		{
+			name:       "Matching version",
+			patch:      `{"name": "Dune: Part One"}`,
+			version:    3,
+			wantFields: []string{"name"},
+			wantEvent:  func(*domain.Movie) {},
+		},
+		{
+			name:    "Stale version",
+			patch:   `{"name": "Dune: Part One"}`,
+			version: 2,
+			wantErr: domain.ErrVersionMismatch,
+		},
+		{
+			name:    "Patch must be an object",
+			patch:   `["name"]`,
+			wantErr: domain.ErrInvalidPatch,
+		},
+		{
+			name:    "Patched event is validated",
+			patch:   `{"name": null}`,
+			wantErr: domain.ErrValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+			auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+			revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+			movieRepo.EXPECT().GetByID(id).Return(stored, nil).MaxTimes(1)
+			if tt.wantErr == nil {
+				revisionRepo.EXPECT().Save(stored).Return(nil)
+				auditRepo.EXPECT().Record(gomock.Any()).Return(nil)
+				movieRepo.EXPECT().Patch(id, gomock.Any(), tt.wantFields, 3).DoAndReturn(
+					func(_ primitive.ObjectID, movie *domain.Movie, _ []string, _ int) (*domain.Movie, error) {
+						tt.wantEvent(movie)
+						return movie, nil
+					})
+			}
+
+			movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+
+			_, err := movieService.Patch(callerContext("admin", domain.RoleAdmin), id, []byte(tt.patch), tt.version)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAuditEventChanges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := primitive.NewObjectID()
+	stored := &domain.Movie{
+		EventBase: domain.EventBase{
+			ID:       id,
+			Name:     "Dune",
+			Duration: 155,
+			Status:   domain.StatusPublished,
+			Version:  3,
+		},
+		ReleaseDate: time.Date(2021, 10, 22, 0, 0, 0, 0, time.UTC),
+	}
+
+	ctx := callerContext("editor@example.com", domain.RolePublisher)
+
+	t.Run("Update records the changed fields", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+		updated := *stored
+		updated.Name = "Dune: Part One"
+		updated.Version = 4
+
+		movieRepo.EXPECT().GetByID(id).Return(stored, nil)
+		revisionRepo.EXPECT().Save(stored).Return(nil)
+		movieRepo.EXPECT().Update(id, gomock.Any(), 3).DoAndReturn(
+			func(_ primitive.ObjectID, movie *domain.Movie, _ int) (*domain.Movie, error) {
+				assert.Equal(t, "editor@example.com", movie.UpdatedBy)
+				return &updated, nil
+			})
+		auditRepo.EXPECT().Record(gomock.Any()).DoAndReturn(func(entry *domain.AuditEntry) error {
+			assert.Equal(t, domain.EventTypeMovie, entry.EntityType)
+			assert.Equal(t, id, entry.EntityID)
+			assert.Equal(t, domain.AuditUpdate, entry.Action)
+			assert.Equal(t, "editor@example.com", entry.Actor)
+			assert.Equal(t, []domain.FieldChange{
+				{Field: "name", Before: "Dune", After: "Dune: Part One"},
+			}, entry.Changes)
+			return nil
+		})
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+
+		movie := *stored
+		movie.Name = "Dune: Part One"
+		_, err := movieService.Update(ctx, id, &movie, 3)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Delete records the removed fields", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+		movieRepo.EXPECT().GetByID(id).Return(stored, nil)
+		movieRepo.EXPECT().Delete(id, 0, "editor@example.com").Return(nil)
+		auditRepo.EXPECT().Record(gomock.Any()).DoAndReturn(func(entry *domain.AuditEntry) error {
+			assert.Equal(t, domain.AuditDelete, entry.Action)
+			assert.Contains(t, entry.Changes, domain.FieldChange{Field: "name", Before: "Dune"})
+			for _, change := range entry.Changes {
+				assert.Nil(t, change.After)
+			}
+			return nil
+		})
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+
+		assert.NoError(t, movieService.Delete(ctx, id, 0))
+	})
+
+	t.Run("Failing to record does not fail the write", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+		movieRepo.EXPECT().GetByID(id).Return(stored, nil)
+		movieRepo.EXPECT().Delete(id, 0, "editor@example.com").Return(nil)
+		auditRepo.EXPECT().Record(gomock.Any()).Return(errors.New("audit log unavailable"))
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+
+		assert.NoError(t, movieService.Delete(ctx, id, 0))
+	})
+}
+
+func TestTrash(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := primitive.NewObjectID()
+	ctx := callerContext("editor@example.com", domain.RolePublisher)
+
+	t.Run("Restore records the restored fields", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+		restored := &domain.Movie{EventBase: domain.EventBase{ID: id, Name: "Dune", Version: 5}}
+
+		movieRepo.EXPECT().Restore(id, "editor@example.com").Return(restored, nil)
+		auditRepo.EXPECT().Record(gomock.Any()).DoAndReturn(func(entry *domain.AuditEntry) error {
+			assert.Equal(t, domain.AuditRestore, entry.Action)
+			assert.Contains(t, entry.Changes, domain.FieldChange{Field: "name", After: "Dune"})
+			return nil
+		})
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+
+		movie, err := movieService.Restore(ctx, id)
+		assert.NoError(t, err)
+		assert.Equal(t, restored, movie)
+	})
+
+	t.Run("Restoring an event outside the trash", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+		movieRepo.EXPECT().Restore(id, "editor@example.com").Return(nil, domain.ErrEventNotFound)
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+
+		_, err := movieService.Restore(ctx, id)
+		assert.ErrorIs(t, err, domain.ErrEventNotFound)
+	})
+
+	t.Run("Purge removes events past the retention", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+		showtimeRepo := mock_repository.NewMockShowtimeRepository(ctrl)
+
+		retention := 30 * 24 * time.Hour
+		purgedID := primitive.NewObjectID()
+
+		movieRepo.EXPECT().Purge(gomock.Any()).DoAndReturn(func(before time.Time) ([]primitive.ObjectID, error) {
+			assert.WithinDuration(t, time.Now().Add(-retention), before, time.Minute)
+			return []primitive.ObjectID{id, purgedID}, nil
+		})
+		revisionRepo.EXPECT().DeleteRevisions([]primitive.ObjectID{id, purgedID}).Return(nil)
+		showtimeRepo.EXPECT().DeleteShowtimesByEvents(domain.EventTypeMovie, []primitive.ObjectID{id, purgedID}).Return(nil)
+		auditRepo.EXPECT().Record(gomock.Any()).DoAndReturn(func(entry *domain.AuditEntry) error {
+			assert.Equal(t, domain.AuditPurge, entry.Action)
+			assert.Equal(t, domain.SystemActor, entry.Actor)
+			assert.Empty(t, entry.Changes)
+			return nil
+		}).Times(2)
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, showtimeRepo)
+
+		purged, err := movieService.PurgeTrash(retention)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, purged)
+	})
+}
+
+func TestRevisions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := primitive.NewObjectID()
+	releaseDate := time.Date(2021, 10, 22, 0, 0, 0, 0, time.UTC)
+	revision := &domain.Movie{
+		EventBase:   domain.EventBase{ID: id, Name: "Dune", Description: "A mythic hero's journey.", Status: domain.StatusDraft, Version: 2},
+		ReleaseDate: releaseDate,
+	}
+	current := &domain.Movie{
+		EventBase:   domain.EventBase{ID: id, Name: "Dune", Description: "asdf", Status: domain.StatusPublished, Version: 3},
+		ReleaseDate: releaseDate,
+	}
+
+	tests := []struct {
+		name     string
+		number   int
+		stored   *domain.Movie
+		want     *domain.Movie
+		wantErr  error
+		expected bool // Whether the revision is looked up in the repository
+	}{
+		{name: "Stored revision", number: 2, stored: revision, want: revision, expected: true},
+		{name: "Current version", number: 3, want: current},
+		{name: "Missing revision", number: 1, wantErr: domain.ErrRevisionNotFound, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+			revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+			movieRepo.EXPECT().GetByID(id).Return(current, nil)
+			if tt.expected {
+				revisionRepo.EXPECT().GetRevision(id, tt.number).Return(tt.stored, nil)
+			}
+
+			movieService := service.NewEventService[domain.Movie](movieRepo, nil, revisionRepo, nil)
+
+			movie, err := movieService.GetRevision(id, tt.number)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, movie)
+		})
+	}
+
+	t.Run("Diff against the current version", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+		movieRepo.EXPECT().GetByID(id).Return(current, nil)
+		revisionRepo.EXPECT().GetRevision(id, 2).Return(revision, nil)
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, nil, revisionRepo, nil)
+
+		diff, err := movieService.DiffRevisions(id, 2, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, &domain.RevisionDiff{
+			From: 2,
+			To:   3,
+			Changes: []domain.FieldChange{
+				{Field: "description", Before: "A mythic hero's journey.", After: "asdf"},
+				{Field: "status", Before: "draft", After: "published"},
+			},
+		}, diff)
+	})
+
+	t.Run("Restore rolls back the content as an update", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+		movieRepo.EXPECT().GetByID(id).Return(current, nil).Times(2)
+		revisionRepo.EXPECT().GetRevision(id, 2).Return(revision, nil)
+		revisionRepo.EXPECT().Save(current).Return(nil)
+		movieRepo.EXPECT().Update(id, gomock.Any(), 3).DoAndReturn(
+			func(_ primitive.ObjectID, movie *domain.Movie, _ int) (*domain.Movie, error) {
+				assert.Equal(t, "A mythic hero's journey.", movie.Description)
+				assert.Equal(t, domain.StatusPublished, movie.Status, "the status is not rolled back")
+				restored := *movie
+				restored.Version = 4
+				return &restored, nil
+			})
+		auditRepo.EXPECT().Record(gomock.Any()).Return(nil)
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+
+		movie, err := movieService.RestoreRevision(callerContext("admin", domain.RoleAdmin), id, 2, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, movie.Version)
+	})
+
+	t.Run("Restore against a stale version", func(t *testing.T) {
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+		movieRepo.EXPECT().GetByID(id).Return(current, nil).Times(2)
+		revisionRepo.EXPECT().GetRevision(id, 2).Return(revision, nil)
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, nil, revisionRepo, nil)
+
+		_, err := movieService.RestoreRevision(callerContext("admin", domain.RoleAdmin), id, 2, 2)
+		assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+	})
+}
+
+func TestPublicationStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	releaseDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tomorrow := time.Now().Add(24 * time.Hour)
+	yesterday := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		base       domain.EventBase
+		wantStatus domain.PublicationStatus
+		wantFields []string
+	}{
+		{
+			name:       "Published without a status",
+			base:       domain.EventBase{Name: "Dune"},
+			wantStatus: domain.StatusPublished,
+		},
+		{
+			name:       "Scheduled by a future publishAt",
+			base:       domain.EventBase{Name: "Dune", PublishAt: &tomorrow},
+			wantStatus: domain.StatusScheduled,
+		},
+		{
+			name:       "Scheduled in the past is published at once",
+			base:       domain.EventBase{Name: "Dune", Status: domain.StatusScheduled, PublishAt: &yesterday},
+			wantStatus: domain.StatusPublished,
+		},
+		{
+			name:       "Drafts stay drafts",
+			base:       domain.EventBase{Name: "Dune", Status: domain.StatusDraft, PublishAt: &yesterday},
+			wantStatus: domain.StatusDraft,
+		},
+		{
+			name:       "Scheduled without publishAt",
+			base:       domain.EventBase{Name: "Dune", Status: domain.StatusScheduled},
+			wantFields: []string{"publishAt"},
+		},
+		{
+			name:       "Unknown status and inverted schedule",
+			base:       domain.EventBase{Name: "Dune", Status: "live", PublishAt: &tomorrow, UnpublishAt: &yesterday},
+			wantFields: []string{"status", "unpublishAt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+			auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+			if tt.wantFields == nil {
+				movieRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(movie *domain.Movie) (*domain.Movie, error) {
+					return movie, nil
+				})
+				auditRepo.EXPECT().Record(gomock.Any()).Return(nil)
+			}
+
+			movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, nil, nil)
+
+			movie, err := movieService.Create(callerContext("admin", domain.RoleAdmin), &domain.Movie{EventBase: tt.base, ReleaseDate: releaseDate})
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantStatus, movie.Status)
+				return
+			}
+
+			var validationErr *domain.ValidationError
+			if assert.True(t, errors.As(err, &validationErr)) {
+				fields := make([]string, 0, len(validationErr.Fields))
+				for _, field := range validationErr.Fields {
+					fields = append(fields, field.Field)
+				}
+				assert.Equal(t, tt.wantFields, fields)
+			}
+		})
+	}
+
+	t.Run("Workflow forbids the change", func(t *testing.T) {
+		id := primitive.NewObjectID()
+		stored := &domain.Movie{
+			EventBase:   domain.EventBase{ID: id, Name: "Dune", Status: domain.StatusArchived, Version: 3},
+			ReleaseDate: releaseDate,
+		}
+
+		movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+		movieRepo.EXPECT().GetByID(id).Return(stored, nil)
+
+		movieService := service.NewEventService[domain.Movie](movieRepo, nil, nil, nil)
+
+		movie := *stored
+		movie.Status = domain.StatusPublished
+		_, err := movieService.Update(callerContext("admin", domain.RoleAdmin), id, &movie, 0)
+
+		var validationErr *domain.ValidationError
+		if assert.True(t, errors.As(err, &validationErr)) {
+			assert.Equal(t, []domain.FieldError{
+				{Field: "status", Message: "cannot change from archived to published"},
+			}, validationErr.Fields)
+		}
+	})
+}
+
+func TestApplySchedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	publishAt := now.Add(-time.Minute)
+	releaseDate := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	scheduled := &domain.Movie{
+		EventBase: domain.EventBase{
+			ID: primitive.NewObjectID(), Name: "Dune", Status: domain.StatusScheduled, PublishAt: &publishAt, Version: 2,
+		},
+		ReleaseDate: releaseDate,
+	}
+	edited := &domain.Movie{
+		EventBase: domain.EventBase{
+			ID: primitive.NewObjectID(), Name: "Arrival", Status: domain.StatusScheduled, PublishAt: &publishAt, Version: 5,
+		},
+		ReleaseDate: releaseDate,
+	}
+
+	movieRepo := mock_repository.NewMockMovieRepository(ctrl)
+	auditRepo := mock_repository.NewMockAuditRepository(ctrl)
+	revisionRepo := mock_repository.NewMockMovieRevisionRepository(ctrl)
+
+	movieRepo.EXPECT().GetScheduleDue(now).Return([]*domain.Movie{scheduled, edited}, nil)
+	revisionRepo.EXPECT().Save(scheduled).Return(nil)
+	revisionRepo.EXPECT().Save(edited).Return(nil)
+	movieRepo.EXPECT().Patch(scheduled.ID, gomock.Any(), []string{"status"}, 2).DoAndReturn(
+		func(_ primitive.ObjectID, movie *domain.Movie, _ []string, _ int) (*domain.Movie, error) {
+			assert.Equal(t, domain.StatusPublished, movie.Status)
+			assert.Equal(t, domain.SystemActor, movie.UpdatedBy)
+			return movie, nil
+		})
+	// Edited since it was read, it is picked up again by the next run.
+	movieRepo.EXPECT().Patch(edited.ID, gomock.Any(), []string{"status"}, 5).Return(nil, domain.ErrVersionMismatch)
+	auditRepo.EXPECT().Record(gomock.Any()).DoAndReturn(func(entry *domain.AuditEntry) error {
+		assert.Equal(t, scheduled.ID, entry.EntityID)
+		assert.Equal(t, domain.SystemActor, entry.Actor)
+		assert.Equal(t, []domain.FieldChange{{Field: "status", Before: "scheduled", After: "published"}}, entry.Changes)
+		return nil
+	})
+
+	movieService := service.NewEventService[domain.Movie](movieRepo, auditRepo, revisionRepo, nil)
+
+	changed, err := movieService.ApplySchedule(now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, changed)
+	assert.Equal(t, domain.StatusScheduled, scheduled.Status, "the stored event is left as read")
+}
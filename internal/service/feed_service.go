@@ -0,0 +1,22 @@
+package service
+
+import (
+	"events/internal/domain"
+	repository "events/internal/repository/interfaces"
+)
+
+type FeedService struct {
+	FeedRepository repository.FeedRepository
+}
+
+func NewFeedService(feedRepository repository.FeedRepository) *FeedService {
+	return &FeedService{FeedRepository: feedRepository}
+}
+
+func (s *FeedService) GetFeed(filter domain.FeedFilter, sort domain.Sort, page, pageSize int) ([]*domain.FeedItem, int, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.To.After(filter.From) {
+		return nil, 0, domain.ErrInvalidTimeRange
+	}
+
+	return s.FeedRepository.GetFeed(filter, sort, page, pageSize)
+}
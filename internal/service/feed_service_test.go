@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"events/internal/domain"
+	mock_repository "events/internal/repository/mocks"
+	"events/internal/service"
+)
+
+func TestGetFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		filter  domain.FeedFilter
+		wantErr error
+	}{
+		{
+			name:   "No filters",
+			filter: domain.FeedFilter{},
+		},
+		{
+			name:   "Open-ended date range",
+			filter: domain.FeedFilter{From: from},
+		},
+		{
+			name:   "Bounded date range",
+			filter: domain.FeedFilter{From: from, To: from.Add(24 * time.Hour)},
+		},
+		{
+			name:    "Range ending before it starts",
+			filter:  domain.FeedFilter{From: from, To: from.Add(-time.Hour)},
+			wantErr: domain.ErrInvalidTimeRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feedRepo := mock_repository.NewMockFeedRepository(ctrl)
+
+			items := []*domain.FeedItem{{Type: domain.EventTypeMovie, Event: &domain.Movie{}}}
+			if tt.wantErr == nil {
+				feedRepo.EXPECT().GetFeed(tt.filter, nil, 1, 10).Return(items, 1, nil)
+			}
+
+			feedService := service.NewFeedService(feedRepo)
+
+			got, total, err := feedService.GetFeed(tt.filter, nil, 1, 10)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, items, got)
+			assert.Equal(t, 1, total)
+		})
+	}
+}
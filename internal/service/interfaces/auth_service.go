@@ -0,0 +1,22 @@
+package service
+
+import (
+	"context"
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=auth_service.go -destination=mocks/auth_service_mock.go
+
+type AuthService interface {
+	AuthenticateToken(token string) (*domain.Principal, error)
+	AuthenticateAPIKey(key string) (*domain.Principal, error)
+	CreateAPIKey(ctx context.Context, request *domain.CreateAPIKeyRequest) (*domain.CreateAPIKeyResponse, error)
+	GetAPIKeys() ([]*domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id primitive.ObjectID) error
+	GetRoleAssignments(page, pageSize int) ([]*domain.RoleAssignment, error)
+	GetRoleAssignmentCount() (int, error)
+	AssignRole(ctx context.Context, assignment *domain.RoleAssignment) (*domain.RoleAssignment, error)
+	RevokeRole(ctx context.Context, id primitive.ObjectID) error
+}
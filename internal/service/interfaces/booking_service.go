@@ -0,0 +1,17 @@
+package service
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=booking_service.go -destination=mocks/booking_service_mock.go
+
+type BookingService interface {
+	GetSeatAvailability(showtimeID primitive.ObjectID) (*domain.SeatAvailability, error)
+	GetBookingByID(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error)
+	HoldSeats(request *domain.CreateBookingRequest) (*domain.CreateBookingResponse, error)
+	ConfirmBooking(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error)
+	CancelBooking(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error)
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"events/internal/domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type EventService[T any] interface {
+	GetAll(filter domain.EventFilter, sort domain.Sort, page, pageSize int) ([]*T, error)
+	GetTotalCount(filter domain.EventFilter) (int, error)
+	GetSearchCount(query string) (int, error)
+	GetFilteredCount(tags []string) (int, error)
+	GetSearchFacets(query string) (*domain.Facets, error)
+	GetFilteredFacets(tags []string) (*domain.Facets, error)
+	GetByID(id primitive.ObjectID) (*T, error)
+	Create(ctx context.Context, event *T) (*T, error)
+	Update(ctx context.Context, id primitive.ObjectID, event *T, version int) (*T, error)
+	Patch(ctx context.Context, id primitive.ObjectID, patch []byte, version int) (*T, error)
+	Delete(ctx context.Context, id primitive.ObjectID, version int) error
+	Restore(ctx context.Context, id primitive.ObjectID) (*T, error)
+	GetTrash(page, pageSize int) ([]*T, error)
+	GetTrashCount() (int, error)
+	PurgeTrash(retention time.Duration) (int, error)
+	ApplySchedule(now time.Time) (int, error)
+	GetHistory(id primitive.ObjectID, page, pageSize int) ([]*domain.AuditEntry, error)
+	GetHistoryCount(id primitive.ObjectID) (int, error)
+	GetRevisions(id primitive.ObjectID, page, pageSize int) ([]*domain.RevisionInfo, error)
+	GetRevisionCount(id primitive.ObjectID) (int, error)
+	GetRevision(id primitive.ObjectID, number int) (*T, error)
+	DiffRevisions(id primitive.ObjectID, from, to int) (*domain.RevisionDiff, error)
+	RestoreRevision(ctx context.Context, id primitive.ObjectID, number int, version int) (*T, error)
+	Search(query string, sort domain.Sort, page int, pageSize int) ([]*T, error)
+	FilterByTags(tags []string, sort domain.Sort, page int, pageSize int) ([]*T, error)
+	Suggest(query string, limit int) ([]*domain.Suggestion, error)
+	GetAllAfter(filter domain.EventFilter, after primitive.ObjectID, pageSize int) ([]*T, error)
+	SearchAfter(query string, after primitive.ObjectID, pageSize int) ([]*T, error)
+	FilterByTagsAfter(tags []string, after primitive.ObjectID, pageSize int) ([]*T, error)
+}
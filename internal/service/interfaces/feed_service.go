@@ -0,0 +1,9 @@
+package service
+
+import "events/internal/domain"
+
+//go:generate mockgen -source=feed_service.go -destination=mocks/feed_service_mock.go
+
+type FeedService interface {
+	GetFeed(filter domain.FeedFilter, sort domain.Sort, page, pageSize int) ([]*domain.FeedItem, int, error)
+}
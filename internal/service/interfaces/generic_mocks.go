@@ -0,0 +1,17 @@
+package service
+
+import "events/internal/domain"
+
+// The interfaces of this package are mocked with mockgen -source, which can't
+// read generic interfaces. EventService is mocked in package mode instead,
+// from the instantiations below; nothing but the generated mocks uses them.
+
+//go:generate mockgen -destination=mocks/event_service_mock.go -package=mock_service events/internal/service/interfaces MovieService,TheatreService
+
+type MovieService interface {
+	EventService[domain.Movie]
+}
+
+type TheatreService interface {
+	EventService[domain.Performance]
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"events/internal/domain"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=showtime_service.go -destination=mocks/showtime_service_mock.go
+
+type ShowtimeService interface {
+	GetShowtimesByEvent(ctx context.Context, eventType domain.EventType, eventID primitive.ObjectID, from, to time.Time) ([]*domain.GetShowtimeResponse, error)
+	GetShowtimesInRange(from, to time.Time, page, pageSize int) ([]*domain.GetShowtimeResponse, error)
+	GetShowtimesInRangeCount(from, to time.Time) (int, error)
+	GetShowtimeByID(id primitive.ObjectID) (*domain.GetShowtimeResponse, error)
+	CreateShowtime(request *domain.CreateShowtimeRequest) (*domain.CreateShowtimeResponse, error)
+	UpdateShowtime(id primitive.ObjectID, request *domain.UpdateShowtimeRequest) (*domain.UpdateShowtimeResponse, error)
+	DeleteShowtime(id primitive.ObjectID) error
+}
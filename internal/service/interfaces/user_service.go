@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=user_service.go -destination=mocks/user_service_mock.go
+
+type UserService interface {
+	Register(request *domain.RegisterRequest) (*domain.User, error)
+	Login(request *domain.LoginRequest) (*domain.TokenPair, error)
+	Refresh(refreshToken string) (*domain.TokenPair, error)
+	Logout(refreshToken string) error
+	ForgotPassword(ctx context.Context, request *domain.ForgotPasswordRequest) error
+	ResetPassword(request *domain.ResetPasswordRequest) error
+	GetUser(id primitive.ObjectID) (*domain.User, error)
+}
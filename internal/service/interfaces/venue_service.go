@@ -0,0 +1,23 @@
+package service
+
+import (
+	"events/internal/domain"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+//go:generate mockgen -source=venue_service.go -destination=mocks/venue_service_mock.go
+
+type VenueService interface {
+	GetAllVenues(sort domain.Sort, page, pageSize int) ([]*domain.GetVenueResponse, error)
+	GetTotalVenuesCount() (int, error)
+	GetVenueByID(id primitive.ObjectID) (*domain.GetVenueResponse, error)
+	CreateVenue(request *domain.CreateVenueRequest) (*domain.CreateVenueResponse, error)
+	UpdateVenue(id primitive.ObjectID, request *domain.UpdateVenueRequest) (*domain.UpdateVenueResponse, error)
+	DeleteVenue(id primitive.ObjectID) error
+	GetHallsByVenue(venueID primitive.ObjectID) ([]*domain.GetHallResponse, error)
+	GetHall(venueID, hallID primitive.ObjectID) (*domain.GetHallResponse, error)
+	CreateHall(venueID primitive.ObjectID, request *domain.CreateHallRequest) (*domain.CreateHallResponse, error)
+	UpdateHall(venueID, hallID primitive.ObjectID, request *domain.UpdateHallRequest) (*domain.UpdateHallResponse, error)
+	DeleteHall(venueID, hallID primitive.ObjectID) error
+}
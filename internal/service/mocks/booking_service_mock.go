@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: booking_service.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	domain "events/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockBookingService is a mock of BookingService interface.
+type MockBookingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingServiceMockRecorder
+}
+
+// MockBookingServiceMockRecorder is the mock recorder for MockBookingService.
+type MockBookingServiceMockRecorder struct {
+	mock *MockBookingService
+}
+
+// NewMockBookingService creates a new mock instance.
+func NewMockBookingService(ctrl *gomock.Controller) *MockBookingService {
+	mock := &MockBookingService{ctrl: ctrl}
+	mock.recorder = &MockBookingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingService) EXPECT() *MockBookingServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelBooking mocks base method.
+func (m *MockBookingService) CancelBooking(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBooking", id, token)
+	ret0, _ := ret[0].(*domain.GetBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelBooking indicates an expected call of CancelBooking.
+func (mr *MockBookingServiceMockRecorder) CancelBooking(id, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBooking", reflect.TypeOf((*MockBookingService)(nil).CancelBooking), id, token)
+}
+
+// ConfirmBooking mocks base method.
+func (m *MockBookingService) ConfirmBooking(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmBooking", id, token)
+	ret0, _ := ret[0].(*domain.GetBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmBooking indicates an expected call of ConfirmBooking.
+func (mr *MockBookingServiceMockRecorder) ConfirmBooking(id, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmBooking", reflect.TypeOf((*MockBookingService)(nil).ConfirmBooking), id, token)
+}
+
+// GetBookingByID mocks base method.
+func (m *MockBookingService) GetBookingByID(id primitive.ObjectID, token string) (*domain.GetBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBookingByID", id, token)
+	ret0, _ := ret[0].(*domain.GetBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBookingByID indicates an expected call of GetBookingByID.
+func (mr *MockBookingServiceMockRecorder) GetBookingByID(id, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBookingByID", reflect.TypeOf((*MockBookingService)(nil).GetBookingByID), id, token)
+}
+
+// GetSeatAvailability mocks base method.
+func (m *MockBookingService) GetSeatAvailability(showtimeID primitive.ObjectID) (*domain.SeatAvailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeatAvailability", showtimeID)
+	ret0, _ := ret[0].(*domain.SeatAvailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeatAvailability indicates an expected call of GetSeatAvailability.
+func (mr *MockBookingServiceMockRecorder) GetSeatAvailability(showtimeID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeatAvailability", reflect.TypeOf((*MockBookingService)(nil).GetSeatAvailability), showtimeID)
+}
+
+// HoldSeats mocks base method.
+func (m *MockBookingService) HoldSeats(request *domain.CreateBookingRequest) (*domain.CreateBookingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldSeats", request)
+	ret0, _ := ret[0].(*domain.CreateBookingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HoldSeats indicates an expected call of HoldSeats.
+func (mr *MockBookingServiceMockRecorder) HoldSeats(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldSeats", reflect.TypeOf((*MockBookingService)(nil).HoldSeats), request)
+}
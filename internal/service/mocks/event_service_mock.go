@@ -0,0 +1,929 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: events/internal/service/interfaces (interfaces: MovieService,TheatreService)
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	domain "events/internal/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockMovieService is a mock of MovieService interface.
+type MockMovieService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMovieServiceMockRecorder
+}
+
+// MockMovieServiceMockRecorder is the mock recorder for MockMovieService.
+type MockMovieServiceMockRecorder struct {
+	mock *MockMovieService
+}
+
+// NewMockMovieService creates a new mock instance.
+func NewMockMovieService(ctrl *gomock.Controller) *MockMovieService {
+	mock := &MockMovieService{ctrl: ctrl}
+	mock.recorder = &MockMovieServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMovieService) EXPECT() *MockMovieServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplySchedule mocks base method.
+func (m *MockMovieService) ApplySchedule(arg0 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplySchedule", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplySchedule indicates an expected call of ApplySchedule.
+func (mr *MockMovieServiceMockRecorder) ApplySchedule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplySchedule", reflect.TypeOf((*MockMovieService)(nil).ApplySchedule), arg0)
+}
+
+// Create mocks base method.
+func (m *MockMovieService) Create(arg0 context.Context, arg1 *domain.Movie) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMovieServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMovieService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockMovieService) Delete(arg0 context.Context, arg1 primitive.ObjectID, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMovieServiceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMovieService)(nil).Delete), arg0, arg1, arg2)
+}
+
+// DiffRevisions mocks base method.
+func (m *MockMovieService) DiffRevisions(arg0 primitive.ObjectID, arg1, arg2 int) (*domain.RevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.RevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockMovieServiceMockRecorder) DiffRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockMovieService)(nil).DiffRevisions), arg0, arg1, arg2)
+}
+
+// FilterByTags mocks base method.
+func (m *MockMovieService) FilterByTags(arg0 []string, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterByTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterByTags indicates an expected call of FilterByTags.
+func (mr *MockMovieServiceMockRecorder) FilterByTags(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterByTags", reflect.TypeOf((*MockMovieService)(nil).FilterByTags), arg0, arg1, arg2, arg3)
+}
+
+// FilterByTagsAfter mocks base method.
+func (m *MockMovieService) FilterByTagsAfter(arg0 []string, arg1 primitive.ObjectID, arg2 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterByTagsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterByTagsAfter indicates an expected call of FilterByTagsAfter.
+func (mr *MockMovieServiceMockRecorder) FilterByTagsAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterByTagsAfter", reflect.TypeOf((*MockMovieService)(nil).FilterByTagsAfter), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockMovieService) GetAll(arg0 domain.EventFilter, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockMovieServiceMockRecorder) GetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockMovieService)(nil).GetAll), arg0, arg1, arg2, arg3)
+}
+
+// GetAllAfter mocks base method.
+func (m *MockMovieService) GetAllAfter(arg0 domain.EventFilter, arg1 primitive.ObjectID, arg2 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAfter indicates an expected call of GetAllAfter.
+func (mr *MockMovieServiceMockRecorder) GetAllAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAfter", reflect.TypeOf((*MockMovieService)(nil).GetAllAfter), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockMovieService) GetByID(arg0 primitive.ObjectID) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockMovieServiceMockRecorder) GetByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockMovieService)(nil).GetByID), arg0)
+}
+
+// GetFilteredCount mocks base method.
+func (m *MockMovieService) GetFilteredCount(arg0 []string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilteredCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilteredCount indicates an expected call of GetFilteredCount.
+func (mr *MockMovieServiceMockRecorder) GetFilteredCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredCount", reflect.TypeOf((*MockMovieService)(nil).GetFilteredCount), arg0)
+}
+
+// GetFilteredFacets mocks base method.
+func (m *MockMovieService) GetFilteredFacets(arg0 []string) (*domain.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilteredFacets", arg0)
+	ret0, _ := ret[0].(*domain.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilteredFacets indicates an expected call of GetFilteredFacets.
+func (mr *MockMovieServiceMockRecorder) GetFilteredFacets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredFacets", reflect.TypeOf((*MockMovieService)(nil).GetFilteredFacets), arg0)
+}
+
+// GetHistory mocks base method.
+func (m *MockMovieService) GetHistory(arg0 primitive.ObjectID, arg1, arg2 int) ([]*domain.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockMovieServiceMockRecorder) GetHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockMovieService)(nil).GetHistory), arg0, arg1, arg2)
+}
+
+// GetHistoryCount mocks base method.
+func (m *MockMovieService) GetHistoryCount(arg0 primitive.ObjectID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryCount indicates an expected call of GetHistoryCount.
+func (mr *MockMovieServiceMockRecorder) GetHistoryCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryCount", reflect.TypeOf((*MockMovieService)(nil).GetHistoryCount), arg0)
+}
+
+// GetRevision mocks base method.
+func (m *MockMovieService) GetRevision(arg0 primitive.ObjectID, arg1 int) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockMovieServiceMockRecorder) GetRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockMovieService)(nil).GetRevision), arg0, arg1)
+}
+
+// GetRevisionCount mocks base method.
+func (m *MockMovieService) GetRevisionCount(arg0 primitive.ObjectID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionCount indicates an expected call of GetRevisionCount.
+func (mr *MockMovieServiceMockRecorder) GetRevisionCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionCount", reflect.TypeOf((*MockMovieService)(nil).GetRevisionCount), arg0)
+}
+
+// GetRevisions mocks base method.
+func (m *MockMovieService) GetRevisions(arg0 primitive.ObjectID, arg1, arg2 int) ([]*domain.RevisionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.RevisionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockMovieServiceMockRecorder) GetRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockMovieService)(nil).GetRevisions), arg0, arg1, arg2)
+}
+
+// GetSearchCount mocks base method.
+func (m *MockMovieService) GetSearchCount(arg0 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchCount indicates an expected call of GetSearchCount.
+func (mr *MockMovieServiceMockRecorder) GetSearchCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCount", reflect.TypeOf((*MockMovieService)(nil).GetSearchCount), arg0)
+}
+
+// GetSearchFacets mocks base method.
+func (m *MockMovieService) GetSearchFacets(arg0 string) (*domain.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchFacets", arg0)
+	ret0, _ := ret[0].(*domain.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchFacets indicates an expected call of GetSearchFacets.
+func (mr *MockMovieServiceMockRecorder) GetSearchFacets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchFacets", reflect.TypeOf((*MockMovieService)(nil).GetSearchFacets), arg0)
+}
+
+// GetTotalCount mocks base method.
+func (m *MockMovieService) GetTotalCount(arg0 domain.EventFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalCount indicates an expected call of GetTotalCount.
+func (mr *MockMovieServiceMockRecorder) GetTotalCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCount", reflect.TypeOf((*MockMovieService)(nil).GetTotalCount), arg0)
+}
+
+// GetTrash mocks base method.
+func (m *MockMovieService) GetTrash(arg0, arg1 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockMovieServiceMockRecorder) GetTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockMovieService)(nil).GetTrash), arg0, arg1)
+}
+
+// GetTrashCount mocks base method.
+func (m *MockMovieService) GetTrashCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashCount indicates an expected call of GetTrashCount.
+func (mr *MockMovieServiceMockRecorder) GetTrashCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashCount", reflect.TypeOf((*MockMovieService)(nil).GetTrashCount))
+}
+
+// Patch mocks base method.
+func (m *MockMovieService) Patch(arg0 context.Context, arg1 primitive.ObjectID, arg2 []byte, arg3 int) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockMovieServiceMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockMovieService)(nil).Patch), arg0, arg1, arg2, arg3)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockMovieService) PurgeTrash(arg0 time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockMovieServiceMockRecorder) PurgeTrash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockMovieService)(nil).PurgeTrash), arg0)
+}
+
+// Restore mocks base method.
+func (m *MockMovieService) Restore(arg0 context.Context, arg1 primitive.ObjectID) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockMovieServiceMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockMovieService)(nil).Restore), arg0, arg1)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockMovieService) RestoreRevision(arg0 context.Context, arg1 primitive.ObjectID, arg2, arg3 int) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockMovieServiceMockRecorder) RestoreRevision(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockMovieService)(nil).RestoreRevision), arg0, arg1, arg2, arg3)
+}
+
+// Search mocks base method.
+func (m *MockMovieService) Search(arg0 string, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockMovieServiceMockRecorder) Search(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockMovieService)(nil).Search), arg0, arg1, arg2, arg3)
+}
+
+// SearchAfter mocks base method.
+func (m *MockMovieService) SearchAfter(arg0 string, arg1 primitive.ObjectID, arg2 int) ([]*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAfter indicates an expected call of SearchAfter.
+func (mr *MockMovieServiceMockRecorder) SearchAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAfter", reflect.TypeOf((*MockMovieService)(nil).SearchAfter), arg0, arg1, arg2)
+}
+
+// Suggest mocks base method.
+func (m *MockMovieService) Suggest(arg0 string, arg1 int) ([]*domain.Suggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Suggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockMovieServiceMockRecorder) Suggest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockMovieService)(nil).Suggest), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockMovieService) Update(arg0 context.Context, arg1 primitive.ObjectID, arg2 *domain.Movie, arg3 int) (*domain.Movie, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Movie)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockMovieServiceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockMovieService)(nil).Update), arg0, arg1, arg2, arg3)
+}
+
+// MockTheatreService is a mock of TheatreService interface.
+type MockTheatreService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTheatreServiceMockRecorder
+}
+
+// MockTheatreServiceMockRecorder is the mock recorder for MockTheatreService.
+type MockTheatreServiceMockRecorder struct {
+	mock *MockTheatreService
+}
+
+// NewMockTheatreService creates a new mock instance.
+func NewMockTheatreService(ctrl *gomock.Controller) *MockTheatreService {
+	mock := &MockTheatreService{ctrl: ctrl}
+	mock.recorder = &MockTheatreServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTheatreService) EXPECT() *MockTheatreServiceMockRecorder {
+	return m.recorder
+}
+
+// ApplySchedule mocks base method.
+func (m *MockTheatreService) ApplySchedule(arg0 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplySchedule", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplySchedule indicates an expected call of ApplySchedule.
+func (mr *MockTheatreServiceMockRecorder) ApplySchedule(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplySchedule", reflect.TypeOf((*MockTheatreService)(nil).ApplySchedule), arg0)
+}
+
+// Create mocks base method.
+func (m *MockTheatreService) Create(arg0 context.Context, arg1 *domain.Performance) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockTheatreServiceMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTheatreService)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockTheatreService) Delete(arg0 context.Context, arg1 primitive.ObjectID, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockTheatreServiceMockRecorder) Delete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockTheatreService)(nil).Delete), arg0, arg1, arg2)
+}
+
+// DiffRevisions mocks base method.
+func (m *MockTheatreService) DiffRevisions(arg0 primitive.ObjectID, arg1, arg2 int) (*domain.RevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.RevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRevisions indicates an expected call of DiffRevisions.
+func (mr *MockTheatreServiceMockRecorder) DiffRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRevisions", reflect.TypeOf((*MockTheatreService)(nil).DiffRevisions), arg0, arg1, arg2)
+}
+
+// FilterByTags mocks base method.
+func (m *MockTheatreService) FilterByTags(arg0 []string, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterByTags", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterByTags indicates an expected call of FilterByTags.
+func (mr *MockTheatreServiceMockRecorder) FilterByTags(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterByTags", reflect.TypeOf((*MockTheatreService)(nil).FilterByTags), arg0, arg1, arg2, arg3)
+}
+
+// FilterByTagsAfter mocks base method.
+func (m *MockTheatreService) FilterByTagsAfter(arg0 []string, arg1 primitive.ObjectID, arg2 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterByTagsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterByTagsAfter indicates an expected call of FilterByTagsAfter.
+func (mr *MockTheatreServiceMockRecorder) FilterByTagsAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterByTagsAfter", reflect.TypeOf((*MockTheatreService)(nil).FilterByTagsAfter), arg0, arg1, arg2)
+}
+
+// GetAll mocks base method.
+func (m *MockTheatreService) GetAll(arg0 domain.EventFilter, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockTheatreServiceMockRecorder) GetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockTheatreService)(nil).GetAll), arg0, arg1, arg2, arg3)
+}
+
+// GetAllAfter mocks base method.
+func (m *MockTheatreService) GetAllAfter(arg0 domain.EventFilter, arg1 primitive.ObjectID, arg2 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAfter indicates an expected call of GetAllAfter.
+func (mr *MockTheatreServiceMockRecorder) GetAllAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAfter", reflect.TypeOf((*MockTheatreService)(nil).GetAllAfter), arg0, arg1, arg2)
+}
+
+// GetByID mocks base method.
+func (m *MockTheatreService) GetByID(arg0 primitive.ObjectID) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockTheatreServiceMockRecorder) GetByID(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockTheatreService)(nil).GetByID), arg0)
+}
+
+// GetFilteredCount mocks base method.
+func (m *MockTheatreService) GetFilteredCount(arg0 []string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilteredCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilteredCount indicates an expected call of GetFilteredCount.
+func (mr *MockTheatreServiceMockRecorder) GetFilteredCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredCount", reflect.TypeOf((*MockTheatreService)(nil).GetFilteredCount), arg0)
+}
+
+// GetFilteredFacets mocks base method.
+func (m *MockTheatreService) GetFilteredFacets(arg0 []string) (*domain.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilteredFacets", arg0)
+	ret0, _ := ret[0].(*domain.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilteredFacets indicates an expected call of GetFilteredFacets.
+func (mr *MockTheatreServiceMockRecorder) GetFilteredFacets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilteredFacets", reflect.TypeOf((*MockTheatreService)(nil).GetFilteredFacets), arg0)
+}
+
+// GetHistory mocks base method.
+func (m *MockTheatreService) GetHistory(arg0 primitive.ObjectID, arg1, arg2 int) ([]*domain.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockTheatreServiceMockRecorder) GetHistory(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockTheatreService)(nil).GetHistory), arg0, arg1, arg2)
+}
+
+// GetHistoryCount mocks base method.
+func (m *MockTheatreService) GetHistoryCount(arg0 primitive.ObjectID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistoryCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistoryCount indicates an expected call of GetHistoryCount.
+func (mr *MockTheatreServiceMockRecorder) GetHistoryCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistoryCount", reflect.TypeOf((*MockTheatreService)(nil).GetHistoryCount), arg0)
+}
+
+// GetRevision mocks base method.
+func (m *MockTheatreService) GetRevision(arg0 primitive.ObjectID, arg1 int) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevision", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevision indicates an expected call of GetRevision.
+func (mr *MockTheatreServiceMockRecorder) GetRevision(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevision", reflect.TypeOf((*MockTheatreService)(nil).GetRevision), arg0, arg1)
+}
+
+// GetRevisionCount mocks base method.
+func (m *MockTheatreService) GetRevisionCount(arg0 primitive.ObjectID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisionCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisionCount indicates an expected call of GetRevisionCount.
+func (mr *MockTheatreServiceMockRecorder) GetRevisionCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisionCount", reflect.TypeOf((*MockTheatreService)(nil).GetRevisionCount), arg0)
+}
+
+// GetRevisions mocks base method.
+func (m *MockTheatreService) GetRevisions(arg0 primitive.ObjectID, arg1, arg2 int) ([]*domain.RevisionInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevisions", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.RevisionInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRevisions indicates an expected call of GetRevisions.
+func (mr *MockTheatreServiceMockRecorder) GetRevisions(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevisions", reflect.TypeOf((*MockTheatreService)(nil).GetRevisions), arg0, arg1, arg2)
+}
+
+// GetSearchCount mocks base method.
+func (m *MockTheatreService) GetSearchCount(arg0 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchCount indicates an expected call of GetSearchCount.
+func (mr *MockTheatreServiceMockRecorder) GetSearchCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchCount", reflect.TypeOf((*MockTheatreService)(nil).GetSearchCount), arg0)
+}
+
+// GetSearchFacets mocks base method.
+func (m *MockTheatreService) GetSearchFacets(arg0 string) (*domain.Facets, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSearchFacets", arg0)
+	ret0, _ := ret[0].(*domain.Facets)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSearchFacets indicates an expected call of GetSearchFacets.
+func (mr *MockTheatreServiceMockRecorder) GetSearchFacets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSearchFacets", reflect.TypeOf((*MockTheatreService)(nil).GetSearchFacets), arg0)
+}
+
+// GetTotalCount mocks base method.
+func (m *MockTheatreService) GetTotalCount(arg0 domain.EventFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalCount", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalCount indicates an expected call of GetTotalCount.
+func (mr *MockTheatreServiceMockRecorder) GetTotalCount(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalCount", reflect.TypeOf((*MockTheatreService)(nil).GetTotalCount), arg0)
+}
+
+// GetTrash mocks base method.
+func (m *MockTheatreService) GetTrash(arg0, arg1 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockTheatreServiceMockRecorder) GetTrash(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockTheatreService)(nil).GetTrash), arg0, arg1)
+}
+
+// GetTrashCount mocks base method.
+func (m *MockTheatreService) GetTrashCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrashCount indicates an expected call of GetTrashCount.
+func (mr *MockTheatreServiceMockRecorder) GetTrashCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashCount", reflect.TypeOf((*MockTheatreService)(nil).GetTrashCount))
+}
+
+// Patch mocks base method.
+func (m *MockTheatreService) Patch(arg0 context.Context, arg1 primitive.ObjectID, arg2 []byte, arg3 int) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockTheatreServiceMockRecorder) Patch(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockTheatreService)(nil).Patch), arg0, arg1, arg2, arg3)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockTheatreService) PurgeTrash(arg0 time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", arg0)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockTheatreServiceMockRecorder) PurgeTrash(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockTheatreService)(nil).PurgeTrash), arg0)
+}
+
+// Restore mocks base method.
+func (m *MockTheatreService) Restore(arg0 context.Context, arg1 primitive.ObjectID) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockTheatreServiceMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockTheatreService)(nil).Restore), arg0, arg1)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockTheatreService) RestoreRevision(arg0 context.Context, arg1 primitive.ObjectID, arg2, arg3 int) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockTheatreServiceMockRecorder) RestoreRevision(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockTheatreService)(nil).RestoreRevision), arg0, arg1, arg2, arg3)
+}
+
+// Search mocks base method.
+func (m *MockTheatreService) Search(arg0 string, arg1 domain.Sort, arg2, arg3 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockTheatreServiceMockRecorder) Search(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockTheatreService)(nil).Search), arg0, arg1, arg2, arg3)
+}
+
+// SearchAfter mocks base method.
+func (m *MockTheatreService) SearchAfter(arg0 string, arg1 primitive.ObjectID, arg2 int) ([]*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchAfter indicates an expected call of SearchAfter.
+func (mr *MockTheatreServiceMockRecorder) SearchAfter(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAfter", reflect.TypeOf((*MockTheatreService)(nil).SearchAfter), arg0, arg1, arg2)
+}
+
+// Suggest mocks base method.
+func (m *MockTheatreService) Suggest(arg0 string, arg1 int) ([]*domain.Suggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", arg0, arg1)
+	ret0, _ := ret[0].([]*domain.Suggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockTheatreServiceMockRecorder) Suggest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockTheatreService)(nil).Suggest), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockTheatreService) Update(arg0 context.Context, arg1 primitive.ObjectID, arg2 *domain.Performance, arg3 int) (*domain.Performance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.Performance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockTheatreServiceMockRecorder) Update(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTheatreService)(nil).Update), arg0, arg1, arg2, arg3)
+}
@@ -0,0 +1,142 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: showtime_service.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	context "context"
+	domain "events/internal/domain"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockShowtimeService is a mock of ShowtimeService interface.
+type MockShowtimeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockShowtimeServiceMockRecorder
+}
+
+// MockShowtimeServiceMockRecorder is the mock recorder for MockShowtimeService.
+type MockShowtimeServiceMockRecorder struct {
+	mock *MockShowtimeService
+}
+
+// NewMockShowtimeService creates a new mock instance.
+func NewMockShowtimeService(ctrl *gomock.Controller) *MockShowtimeService {
+	mock := &MockShowtimeService{ctrl: ctrl}
+	mock.recorder = &MockShowtimeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockShowtimeService) EXPECT() *MockShowtimeServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateShowtime mocks base method.
+func (m *MockShowtimeService) CreateShowtime(request *domain.CreateShowtimeRequest) (*domain.CreateShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShowtime", request)
+	ret0, _ := ret[0].(*domain.CreateShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShowtime indicates an expected call of CreateShowtime.
+func (mr *MockShowtimeServiceMockRecorder) CreateShowtime(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShowtime", reflect.TypeOf((*MockShowtimeService)(nil).CreateShowtime), request)
+}
+
+// DeleteShowtime mocks base method.
+func (m *MockShowtimeService) DeleteShowtime(id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteShowtime", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteShowtime indicates an expected call of DeleteShowtime.
+func (mr *MockShowtimeServiceMockRecorder) DeleteShowtime(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteShowtime", reflect.TypeOf((*MockShowtimeService)(nil).DeleteShowtime), id)
+}
+
+// GetShowtimeByID mocks base method.
+func (m *MockShowtimeService) GetShowtimeByID(id primitive.ObjectID) (*domain.GetShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimeByID", id)
+	ret0, _ := ret[0].(*domain.GetShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShowtimeByID indicates an expected call of GetShowtimeByID.
+func (mr *MockShowtimeServiceMockRecorder) GetShowtimeByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimeByID", reflect.TypeOf((*MockShowtimeService)(nil).GetShowtimeByID), id)
+}
+
+// GetShowtimesByEvent mocks base method.
+func (m *MockShowtimeService) GetShowtimesByEvent(ctx context.Context, eventType domain.EventType, eventID primitive.ObjectID, from, to time.Time) ([]*domain.GetShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimesByEvent", ctx, eventType, eventID, from, to)
+	ret0, _ := ret[0].([]*domain.GetShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShowtimesByEvent indicates an expected call of GetShowtimesByEvent.
+func (mr *MockShowtimeServiceMockRecorder) GetShowtimesByEvent(ctx, eventType, eventID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimesByEvent", reflect.TypeOf((*MockShowtimeService)(nil).GetShowtimesByEvent), ctx, eventType, eventID, from, to)
+}
+
+// GetShowtimesInRange mocks base method.
+func (m *MockShowtimeService) GetShowtimesInRange(from, to time.Time, page, pageSize int) ([]*domain.GetShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimesInRange", from, to, page, pageSize)
+	ret0, _ := ret[0].([]*domain.GetShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShowtimesInRange indicates an expected call of GetShowtimesInRange.
+func (mr *MockShowtimeServiceMockRecorder) GetShowtimesInRange(from, to, page, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimesInRange", reflect.TypeOf((*MockShowtimeService)(nil).GetShowtimesInRange), from, to, page, pageSize)
+}
+
+// GetShowtimesInRangeCount mocks base method.
+func (m *MockShowtimeService) GetShowtimesInRangeCount(from, to time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetShowtimesInRangeCount", from, to)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetShowtimesInRangeCount indicates an expected call of GetShowtimesInRangeCount.
+func (mr *MockShowtimeServiceMockRecorder) GetShowtimesInRangeCount(from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShowtimesInRangeCount", reflect.TypeOf((*MockShowtimeService)(nil).GetShowtimesInRangeCount), from, to)
+}
+
+// UpdateShowtime mocks base method.
+func (m *MockShowtimeService) UpdateShowtime(id primitive.ObjectID, request *domain.UpdateShowtimeRequest) (*domain.UpdateShowtimeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateShowtime", id, request)
+	ret0, _ := ret[0].(*domain.UpdateShowtimeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateShowtime indicates an expected call of UpdateShowtime.
+func (mr *MockShowtimeServiceMockRecorder) UpdateShowtime(id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateShowtime", reflect.TypeOf((*MockShowtimeService)(nil).UpdateShowtime), id, request)
+}
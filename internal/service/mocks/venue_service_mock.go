@@ -0,0 +1,199 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: venue_service.go
+
+// Package mock_service is a generated GoMock package.
+package mock_service
+
+import (
+	domain "events/internal/domain"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	primitive "go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// MockVenueService is a mock of VenueService interface.
+type MockVenueService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVenueServiceMockRecorder
+}
+
+// MockVenueServiceMockRecorder is the mock recorder for MockVenueService.
+type MockVenueServiceMockRecorder struct {
+	mock *MockVenueService
+}
+
+// NewMockVenueService creates a new mock instance.
+func NewMockVenueService(ctrl *gomock.Controller) *MockVenueService {
+	mock := &MockVenueService{ctrl: ctrl}
+	mock.recorder = &MockVenueServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVenueService) EXPECT() *MockVenueServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateHall mocks base method.
+func (m *MockVenueService) CreateHall(venueID primitive.ObjectID, request *domain.CreateHallRequest) (*domain.CreateHallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHall", venueID, request)
+	ret0, _ := ret[0].(*domain.CreateHallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHall indicates an expected call of CreateHall.
+func (mr *MockVenueServiceMockRecorder) CreateHall(venueID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHall", reflect.TypeOf((*MockVenueService)(nil).CreateHall), venueID, request)
+}
+
+// CreateVenue mocks base method.
+func (m *MockVenueService) CreateVenue(request *domain.CreateVenueRequest) (*domain.CreateVenueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVenue", request)
+	ret0, _ := ret[0].(*domain.CreateVenueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVenue indicates an expected call of CreateVenue.
+func (mr *MockVenueServiceMockRecorder) CreateVenue(request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVenue", reflect.TypeOf((*MockVenueService)(nil).CreateVenue), request)
+}
+
+// DeleteHall mocks base method.
+func (m *MockVenueService) DeleteHall(venueID, hallID primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHall", venueID, hallID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHall indicates an expected call of DeleteHall.
+func (mr *MockVenueServiceMockRecorder) DeleteHall(venueID, hallID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHall", reflect.TypeOf((*MockVenueService)(nil).DeleteHall), venueID, hallID)
+}
+
+// DeleteVenue mocks base method.
+func (m *MockVenueService) DeleteVenue(id primitive.ObjectID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVenue", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVenue indicates an expected call of DeleteVenue.
+func (mr *MockVenueServiceMockRecorder) DeleteVenue(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVenue", reflect.TypeOf((*MockVenueService)(nil).DeleteVenue), id)
+}
+
+// GetAllVenues mocks base method.
+func (m *MockVenueService) GetAllVenues(sort domain.Sort, page, pageSize int) ([]*domain.GetVenueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllVenues", sort, page, pageSize)
+	ret0, _ := ret[0].([]*domain.GetVenueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllVenues indicates an expected call of GetAllVenues.
+func (mr *MockVenueServiceMockRecorder) GetAllVenues(sort, page, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllVenues", reflect.TypeOf((*MockVenueService)(nil).GetAllVenues), sort, page, pageSize)
+}
+
+// GetHall mocks base method.
+func (m *MockVenueService) GetHall(venueID, hallID primitive.ObjectID) (*domain.GetHallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHall", venueID, hallID)
+	ret0, _ := ret[0].(*domain.GetHallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHall indicates an expected call of GetHall.
+func (mr *MockVenueServiceMockRecorder) GetHall(venueID, hallID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHall", reflect.TypeOf((*MockVenueService)(nil).GetHall), venueID, hallID)
+}
+
+// GetHallsByVenue mocks base method.
+func (m *MockVenueService) GetHallsByVenue(venueID primitive.ObjectID) ([]*domain.GetHallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHallsByVenue", venueID)
+	ret0, _ := ret[0].([]*domain.GetHallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHallsByVenue indicates an expected call of GetHallsByVenue.
+func (mr *MockVenueServiceMockRecorder) GetHallsByVenue(venueID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHallsByVenue", reflect.TypeOf((*MockVenueService)(nil).GetHallsByVenue), venueID)
+}
+
+// GetTotalVenuesCount mocks base method.
+func (m *MockVenueService) GetTotalVenuesCount() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalVenuesCount")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalVenuesCount indicates an expected call of GetTotalVenuesCount.
+func (mr *MockVenueServiceMockRecorder) GetTotalVenuesCount() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalVenuesCount", reflect.TypeOf((*MockVenueService)(nil).GetTotalVenuesCount))
+}
+
+// GetVenueByID mocks base method.
+func (m *MockVenueService) GetVenueByID(id primitive.ObjectID) (*domain.GetVenueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVenueByID", id)
+	ret0, _ := ret[0].(*domain.GetVenueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVenueByID indicates an expected call of GetVenueByID.
+func (mr *MockVenueServiceMockRecorder) GetVenueByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVenueByID", reflect.TypeOf((*MockVenueService)(nil).GetVenueByID), id)
+}
+
+// UpdateHall mocks base method.
+func (m *MockVenueService) UpdateHall(venueID, hallID primitive.ObjectID, request *domain.UpdateHallRequest) (*domain.UpdateHallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHall", venueID, hallID, request)
+	ret0, _ := ret[0].(*domain.UpdateHallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHall indicates an expected call of UpdateHall.
+func (mr *MockVenueServiceMockRecorder) UpdateHall(venueID, hallID, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHall", reflect.TypeOf((*MockVenueService)(nil).UpdateHall), venueID, hallID, request)
+}
+
+// UpdateVenue mocks base method.
+func (m *MockVenueService) UpdateVenue(id primitive.ObjectID, request *domain.UpdateVenueRequest) (*domain.UpdateVenueResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVenue", id, request)
+	ret0, _ := ret[0].(*domain.UpdateVenueResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVenue indicates an expected call of UpdateVenue.
+func (mr *MockVenueServiceMockRecorder) UpdateVenue(id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVenue", reflect.TypeOf((*MockVenueService)(nil).UpdateVenue), id, request)
+}
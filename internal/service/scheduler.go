@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"time"
+)
+
+// StatusScheduler moves the publication statuses of events on once their
+// publishAt or unpublishAt has passed. Every EventService is one.
+type StatusScheduler interface {
+	ApplySchedule(now time.Time) (int, error)
+}
+
+// RunStatusScheduler applies the schedule of every scheduler once per
+// interval until ctx is done, so statuses change at most an interval late.
+func RunStatusScheduler(ctx context.Context, schedulers []StatusScheduler, interval time.Duration) {
+	runEvery(ctx, interval, func() {
+		now := time.Now()
+		for _, scheduler := range schedulers {
+			changed, err := scheduler.ApplySchedule(now)
+			if err != nil {
+				slog.Error("Error applying publication schedule: ", utils.Err(err))
+				continue
+			}
+			if changed > 0 {
+				slog.Info("Applied publication schedule", slog.Int("count", changed))
+			}
+		}
+	})
+}
+
+// runEvery runs job at once and then once per interval until ctx is done.
+func runEvery(ctx context.Context, interval time.Duration, job func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+const secretBytes = 32
+
+// newSecret returns a random secret for API keys and user tokens, starting
+// with prefix.
+func newSecret(prefix string) (string, error) {
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return prefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashSecret hashes a secret for storage. Secrets are long and random, so a
+// fast unsalted hash is enough and lets them be looked up by their hash.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"events/internal/domain"
+	repository "events/internal/repository/interfaces"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ShowtimeService struct {
+	ShowtimeRepository repository.ShowtimeRepository
+	HallRepository     repository.HallRepository
+	Events             repository.EventCatalog
+}
+
+func NewShowtimeService(showtimeRepository repository.ShowtimeRepository, hallRepository repository.HallRepository, events repository.EventCatalog) *ShowtimeService {
+	return &ShowtimeService{
+		ShowtimeRepository: showtimeRepository,
+		HallRepository:     hallRepository,
+		Events:             events,
+	}
+}
+
+// GetShowtimesByEvent lists the showtimes of the event. Like the event
+// itself, the schedule of an unpublished event takes the read permission
+// for its kind; other callers are told it doesn't exist.
+func (s *ShowtimeService) GetShowtimesByEvent(ctx context.Context, eventType domain.EventType, eventID primitive.ObjectID, from, to time.Time) ([]*domain.GetShowtimeResponse, error) {
+	event, err := s.findEvent(eventType, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status != domain.StatusPublished && !domain.PrincipalFrom(ctx).Can(domain.PermissionRead, eventType) {
+		return nil, domain.ErrEventNotFound
+	}
+
+	return s.ShowtimeRepository.GetShowtimesByEvent(eventType, eventID, from, to)
+}
+
+func (s *ShowtimeService) GetShowtimesInRange(from, to time.Time, page, pageSize int) ([]*domain.GetShowtimeResponse, error) {
+	return s.ShowtimeRepository.GetShowtimesInRange(from, to, page, pageSize)
+}
+
+func (s *ShowtimeService) GetShowtimesInRangeCount(from, to time.Time) (int, error) {
+	return s.ShowtimeRepository.GetShowtimesInRangeCount(from, to)
+}
+
+func (s *ShowtimeService) GetShowtimeByID(id primitive.ObjectID) (*domain.GetShowtimeResponse, error) {
+	return s.ShowtimeRepository.GetShowtimeByID(id)
+}
+
+func (s *ShowtimeService) CreateShowtime(request *domain.CreateShowtimeRequest) (*domain.CreateShowtimeResponse, error) {
+	if err := s.prepareShowtime((*domain.CommonShowtimeRequest)(request)); err != nil {
+		return nil, err
+	}
+
+	return s.ShowtimeRepository.CreateShowtime(request)
+}
+
+func (s *ShowtimeService) UpdateShowtime(id primitive.ObjectID, request *domain.UpdateShowtimeRequest) (*domain.UpdateShowtimeResponse, error) {
+	if err := s.prepareShowtime((*domain.CommonShowtimeRequest)(request)); err != nil {
+		return nil, err
+	}
+
+	return s.ShowtimeRepository.UpdateShowtime(id, request)
+}
+
+func (s *ShowtimeService) DeleteShowtime(id primitive.ObjectID) error {
+	return s.ShowtimeRepository.DeleteShowtime(id)
+}
+
+// prepareShowtime checks that the showtime points to an existing event and
+// hall, and fills in the end time from the event duration when the client
+// omitted it. Price tiers must match the seat categories of the hall.
+func (s *ShowtimeService) prepareShowtime(showtime *domain.CommonShowtimeRequest) error {
+	event, err := s.findEvent(showtime.EventType, showtime.EventID)
+	if err != nil {
+		return err
+	}
+
+	if showtime.EndTime.IsZero() {
+		if event.Duration == 0 {
+			return domain.ErrInvalidDuration
+		}
+		showtime.EndTime = showtime.StartTime.Add(event.Duration.Duration())
+	}
+
+	if showtime.StartTime.IsZero() || !showtime.EndTime.After(showtime.StartTime) {
+		return domain.ErrInvalidShowtime
+	}
+
+	if showtime.Status == "" {
+		showtime.Status = domain.ShowtimeScheduled
+	}
+	if !showtime.Status.IsValid() {
+		return domain.ErrInvalidShowtimeStatus
+	}
+
+	hall, err := s.HallRepository.GetHallByID(showtime.HallID)
+	if err != nil {
+		return err
+	}
+	if hall == nil || hall.VenueID != showtime.VenueID {
+		return domain.ErrHallNotFound
+	}
+
+	categories := make(map[string]bool, len(hall.SeatCategories))
+	for _, category := range hall.SeatCategories {
+		categories[category.Code] = true
+	}
+
+	for _, tier := range showtime.PriceTiers {
+		if !categories[tier.Category] || tier.Price < 0 {
+			return domain.ErrInvalidPriceTier
+		}
+	}
+
+	return nil
+}
+
+// findEvent returns the referenced event, failing when it does not exist.
+func (s *ShowtimeService) findEvent(eventType domain.EventType, eventID primitive.ObjectID) (*domain.EventBase, error) {
+	events, ok := s.Events[eventType]
+	if !ok {
+		return nil, domain.ErrInvalidEventType
+	}
+
+	event, err := events.GetBaseByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, domain.ErrEventNotFound
+	}
+
+	return event, nil
+}
@@ -0,0 +1,211 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"events/internal/domain"
+	repository "events/internal/repository/interfaces"
+	mock_repository "events/internal/repository/mocks"
+	"events/internal/service"
+)
+
+func TestCreateShowtime(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieID := primitive.NewObjectID()
+	venueID := primitive.NewObjectID()
+	hall := &domain.GetHallResponse{
+		ID:             primitive.NewObjectID(),
+		VenueID:        venueID,
+		SeatCategories: []domain.SeatCategory{{Code: "standard"}},
+	}
+	startTime := time.Date(2024, 3, 1, 19, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		movie       *domain.EventBase
+		request     *domain.CreateShowtimeRequest
+		wantEndTime time.Time
+		wantErr     error
+	}{
+		{
+			name:  "End time derived from movie duration",
+			movie: &domain.EventBase{ID: movieID, Duration: 130},
+			request: &domain.CreateShowtimeRequest{
+				EventID:   movieID,
+				EventType: domain.EventTypeMovie,
+				VenueID:   venueID,
+				HallID:    hall.ID,
+				StartTime: startTime,
+			},
+			wantEndTime: startTime.Add(130 * time.Minute),
+		},
+		{
+			name:  "Explicit end time is kept",
+			movie: &domain.EventBase{ID: movieID, Duration: 0},
+			request: &domain.CreateShowtimeRequest{
+				EventID:   movieID,
+				EventType: domain.EventTypeMovie,
+				VenueID:   venueID,
+				HallID:    hall.ID,
+				StartTime: startTime,
+				EndTime:   startTime.Add(time.Hour),
+			},
+			wantEndTime: startTime.Add(time.Hour),
+		},
+		{
+			name:  "Unparsable duration without end time",
+			movie: &domain.EventBase{ID: movieID, Duration: 0},
+			request: &domain.CreateShowtimeRequest{
+				EventID:   movieID,
+				EventType: domain.EventTypeMovie,
+				VenueID:   venueID,
+				HallID:    hall.ID,
+				StartTime: startTime,
+			},
+			wantErr: domain.ErrInvalidDuration,
+		},
+		{
+			name:  "Missing movie",
+			movie: nil,
+			request: &domain.CreateShowtimeRequest{
+				EventID:   movieID,
+				EventType: domain.EventTypeMovie,
+				VenueID:   venueID,
+				HallID:    hall.ID,
+				StartTime: startTime,
+			},
+			wantErr: domain.ErrEventNotFound,
+		},
+		{
+			name:  "Price tier for an unknown seat category",
+			movie: &domain.EventBase{ID: movieID, Duration: 90},
+			request: &domain.CreateShowtimeRequest{
+				EventID:    movieID,
+				EventType:  domain.EventTypeMovie,
+				VenueID:    venueID,
+				HallID:     hall.ID,
+				StartTime:  startTime,
+				PriceTiers: []domain.PriceTier{{Category: "vip", Price: 100}},
+			},
+			wantErr: domain.ErrInvalidPriceTier,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			showtimeRepo := mock_repository.NewMockShowtimeRepository(ctrl)
+			movieRepo := mock_repository.NewMockEventFinder(ctrl)
+			hallRepo := mock_repository.NewMockHallRepository(ctrl)
+
+			movieRepo.EXPECT().GetBaseByID(movieID).Return(tt.movie, nil)
+			if tt.wantErr == nil || tt.wantErr == domain.ErrInvalidPriceTier {
+				hallRepo.EXPECT().GetHallByID(hall.ID).Return(hall, nil)
+			}
+			if tt.wantErr == nil {
+				showtimeRepo.EXPECT().CreateShowtime(gomock.Any()).DoAndReturn(
+					func(request *domain.CreateShowtimeRequest) (*domain.CreateShowtimeResponse, error) {
+						return &domain.CreateShowtimeResponse{
+							ID:        primitive.NewObjectID(),
+							EventID:   request.EventID,
+							EventType: request.EventType,
+							StartTime: request.StartTime,
+							EndTime:   request.EndTime,
+							Status:    request.Status,
+						}, nil
+					})
+			}
+
+			showtimeService := service.NewShowtimeService(showtimeRepo, hallRepo, repository.EventCatalog{
+				domain.EventTypeMovie: movieRepo,
+			})
+
+			got, err := showtimeService.CreateShowtime(tt.request)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEndTime, got.EndTime)
+			assert.Equal(t, domain.ShowtimeScheduled, got.Status)
+		})
+	}
+}
+
+func TestGetShowtimesByEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	movieID := primitive.NewObjectID()
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(30 * 24 * time.Hour)
+	showtimes := []*domain.GetShowtimeResponse{{ID: primitive.NewObjectID(), EventID: movieID}}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		movie   *domain.EventBase
+		wantErr error
+	}{
+		{
+			name:  "Published event",
+			ctx:   context.Background(),
+			movie: &domain.EventBase{ID: movieID, Status: domain.StatusPublished},
+		},
+		{
+			name:    "Draft hidden from the public",
+			ctx:     context.Background(),
+			movie:   &domain.EventBase{ID: movieID, Status: domain.StatusDraft},
+			wantErr: domain.ErrEventNotFound,
+		},
+		{
+			name:    "Archived hidden from readers of other kinds",
+			ctx:     callerContext("viewer", domain.RoleViewer, domain.EventTypeConcert),
+			movie:   &domain.EventBase{ID: movieID, Status: domain.StatusArchived},
+			wantErr: domain.ErrEventNotFound,
+		},
+		{
+			name:  "Scheduled shown to readers of the kind",
+			ctx:   callerContext("viewer", domain.RoleViewer, domain.EventTypeMovie),
+			movie: &domain.EventBase{ID: movieID, Status: domain.StatusScheduled},
+		},
+		{
+			name:    "Missing event",
+			ctx:     context.Background(),
+			wantErr: domain.ErrEventNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			showtimeRepo := mock_repository.NewMockShowtimeRepository(ctrl)
+			movieRepo := mock_repository.NewMockEventFinder(ctrl)
+
+			movieRepo.EXPECT().GetBaseByID(movieID).Return(tt.movie, nil)
+			if tt.wantErr == nil {
+				showtimeRepo.EXPECT().GetShowtimesByEvent(domain.EventTypeMovie, movieID, from, to).Return(showtimes, nil)
+			}
+
+			showtimeService := service.NewShowtimeService(showtimeRepo, nil, repository.EventCatalog{
+				domain.EventTypeMovie: movieRepo,
+			})
+
+			got, err := showtimeService.GetShowtimesByEvent(tt.ctx, domain.EventTypeMovie, movieID, from, to)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, showtimes, got)
+		})
+	}
+}
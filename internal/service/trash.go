@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"events/pkg/lib/utils"
+	"log/slog"
+	"time"
+)
+
+// TrashPurger permanently removes what has been in its trash for longer
+// than the retention period. Every EventService is one.
+type TrashPurger interface {
+	PurgeTrash(retention time.Duration) (int, error)
+}
+
+// RunTrashPurge purges every purger once per interval until ctx is done. A
+// failing purger is logged and retried at the next interval.
+func RunTrashPurge(ctx context.Context, purgers []TrashPurger, retention, interval time.Duration) {
+	runEvery(ctx, interval, func() {
+		for _, purger := range purgers {
+			purged, err := purger.PurgeTrash(retention)
+			if err != nil {
+				slog.Error("Error purging trash: ", utils.Err(err))
+				continue
+			}
+			if purged > 0 {
+				slog.Info("Purged trashed items", slog.Int("count", purged))
+			}
+		}
+	})
+}
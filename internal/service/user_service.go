@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"events/internal/domain"
+	"events/internal/notifier"
+	repository "events/internal/repository/interfaces"
+	"events/pkg/lib/jwt"
+	"fmt"
+	"log/slog"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	refreshTokenPrefix = "evr_"
+	resetTokenPrefix   = "evp_"
+
+	// bcrypt ignores everything past 72 bytes of a password.
+	maxPasswordBytes = 72
+)
+
+// UserSettings hold how the tokens issued to users are signed and how long
+// they last. Access tokens are HS256 JWTs, so the API verifies them like
+// any other bearer token.
+type UserSettings struct {
+	TokenSecret       []byte
+	Issuer            string
+	Audience          string
+	AccessTokenTTL    time.Duration
+	RefreshTokenTTL   time.Duration
+	ResetTokenTTL     time.Duration
+	MinPasswordLength int
+	PasswordCost      int    // bcrypt cost, the bcrypt default when zero
+	ResetURL          string // Link the reset token is appended to, if any
+}
+
+// UserService manages customer accounts: registration, login with rotating
+// refresh tokens, and password resets.
+type UserService struct {
+	UserRepository  repository.UserRepository
+	TokenRepository repository.UserTokenRepository
+	Notifier        notifier.Notifier
+	Settings        UserSettings
+
+	dummyHash     []byte
+	dummyHashOnce sync.Once
+}
+
+func NewUserService(userRepository repository.UserRepository, tokenRepository repository.UserTokenRepository, notifier notifier.Notifier, settings UserSettings) *UserService {
+	if settings.PasswordCost == 0 {
+		settings.PasswordCost = bcrypt.DefaultCost
+	}
+
+	return &UserService{
+		UserRepository:  userRepository,
+		TokenRepository: tokenRepository,
+		Notifier:        notifier,
+		Settings:        settings,
+	}
+}
+
+func (s *UserService) Register(request *domain.RegisterRequest) (*domain.User, error) {
+	email := normalizeEmail(request.Email)
+	name := strings.TrimSpace(request.Name)
+
+	v := &validator{}
+	v.check(isEmail(email), "email", "must be a valid email address")
+	v.maxLength("name", name, maxNameLength)
+	s.validatePassword(v, "password", request.Password)
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(request.Password), s.Settings.PasswordCost)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return s.UserRepository.Create(&domain.User{
+		Email:        email,
+		Name:         name,
+		PasswordHash: string(hash),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	})
+}
+
+// Login checks the password of a user and starts a session. An unknown
+// email and a wrong password can't be told apart, not even by timing.
+func (s *UserService) Login(request *domain.LoginRequest) (*domain.TokenPair, error) {
+	user, err := s.UserRepository.GetByEmail(normalizeEmail(request.Email))
+	if errors.Is(err, domain.ErrUserNotFound) {
+		bcrypt.CompareHashAndPassword(s.getDummyHash(), []byte(request.Password))
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)) != nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+
+	return s.issueTokens(user, primitive.NewObjectID())
+}
+
+// Refresh trades a refresh token for a new pair. Each refresh token works
+// once; presenting one again means it leaked, and ends the session it
+// belongs to for whoever holds it.
+func (s *UserService) Refresh(refreshToken string) (*domain.TokenPair, error) {
+	now := time.Now().UTC()
+	hash := hashSecret(refreshToken)
+
+	token, err := s.TokenRepository.Use(domain.UserTokenRefresh, hash, now)
+	if errors.Is(err, domain.ErrTokenNotFound) {
+		if err := s.revokeReused(hash, now); err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.UserRepository.GetByID(token.UserID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, domain.ErrInvalidToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(user, token.Family)
+}
+
+// Logout ends the session of a refresh token. Unknown tokens are ignored.
+func (s *UserService) Logout(refreshToken string) error {
+	token, err := s.TokenRepository.GetByHash(domain.UserTokenRefresh, hashSecret(refreshToken))
+	if errors.Is(err, domain.ErrTokenNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.TokenRepository.RevokeFamily(token.Family, time.Now().UTC())
+}
+
+// ForgotPassword sends a password reset token to the user with the given
+// email. Unknown emails are ignored, so that the endpoint can't be used to
+// find out who has an account. Only the latest token works.
+func (s *UserService) ForgotPassword(ctx context.Context, request *domain.ForgotPasswordRequest) error {
+	user, err := s.UserRepository.GetByEmail(normalizeEmail(request.Email))
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	secret, err := newSecret(resetTokenPrefix)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if err := s.TokenRepository.RevokeUserTokens(user.ID, domain.UserTokenPasswordReset, now); err != nil {
+		return err
+	}
+	if err := s.TokenRepository.Create(&domain.UserToken{
+		UserID:    user.ID,
+		Kind:      domain.UserTokenPasswordReset,
+		Hash:      hashSecret(secret),
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.Settings.ResetTokenTTL),
+	}); err != nil {
+		return err
+	}
+
+	return s.Notifier.Notify(ctx, domain.Notification{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body:    s.resetMessage(secret),
+	})
+}
+
+// ResetPassword sets a new password with a reset token and ends every
+// session of the user.
+func (s *UserService) ResetPassword(request *domain.ResetPasswordRequest) error {
+	v := &validator{}
+	s.validatePassword(v, "password", request.Password)
+	if err := v.err(); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	token, err := s.TokenRepository.Use(domain.UserTokenPasswordReset, hashSecret(request.Token), now)
+	if errors.Is(err, domain.ErrTokenNotFound) {
+		return domain.ErrInvalidToken
+	}
+	if err != nil {
+		return err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(request.Password), s.Settings.PasswordCost)
+	if err != nil {
+		return err
+	}
+
+	if err := s.UserRepository.UpdatePassword(token.UserID, string(hash)); err != nil {
+		return err
+	}
+
+	return s.TokenRepository.RevokeUserTokens(token.UserID, domain.UserTokenRefresh, now)
+}
+
+func (s *UserService) GetUser(id primitive.ObjectID) (*domain.User, error) {
+	return s.UserRepository.GetByID(id)
+}
+
+func (s *UserService) issueTokens(user *domain.User, family primitive.ObjectID) (*domain.TokenPair, error) {
+	now := time.Now().UTC()
+
+	claims := &jwt.Claims{
+		Subject:   user.Subject(),
+		Name:      user.Name,
+		Issuer:    s.Settings.Issuer,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.Settings.AccessTokenTTL).Unix(),
+	}
+	if s.Settings.Audience != "" {
+		claims.Audience = jwt.Audience{s.Settings.Audience}
+	}
+
+	accessToken, err := jwt.Sign(claims, s.Settings.TokenSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := newSecret(refreshTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.TokenRepository.Create(&domain.UserToken{
+		UserID:    user.ID,
+		Kind:      domain.UserTokenRefresh,
+		Hash:      hashSecret(refreshToken),
+		Family:    family,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.Settings.RefreshTokenTTL),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int(s.Settings.AccessTokenTTL.Seconds()),
+	}, nil
+}
+
+// revokeReused ends the session of a refresh token that was used or revoked
+// before.
+func (s *UserService) revokeReused(hash string, now time.Time) error {
+	token, err := s.TokenRepository.GetByHash(domain.UserTokenRefresh, hash)
+	if errors.Is(err, domain.ErrTokenNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if token.UsedAt != nil {
+		slog.Warn("refresh token reused, revoking its session", slog.String("userId", token.UserID.Hex()))
+		return s.TokenRepository.RevokeFamily(token.Family, now)
+	}
+
+	return nil
+}
+
+func (s *UserService) resetMessage(secret string) string {
+	if s.Settings.ResetURL != "" {
+		return fmt.Sprintf("Open %s%s to choose a new password.", s.Settings.ResetURL, secret)
+	}
+
+	return fmt.Sprintf("Use this token to choose a new password: %s", secret)
+}
+
+func (s *UserService) validatePassword(v *validator, field, password string) {
+	v.check(len([]rune(password)) >= s.Settings.MinPasswordLength, field, "must be at least %d characters", s.Settings.MinPasswordLength)
+	v.check(len(password) <= maxPasswordBytes, field, "must be at most %d bytes", maxPasswordBytes)
+}
+
+// getDummyHash returns a hash to check passwords against when there is no
+// user, so that a failed login takes as long either way.
+func (s *UserService) getDummyHash() []byte {
+	s.dummyHashOnce.Do(func() {
+		s.dummyHash, _ = bcrypt.GenerateFromPassword([]byte("not a password"), s.Settings.PasswordCost)
+	})
+
+	return s.dummyHash
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func isEmail(email string) bool {
+	address, err := mail.ParseAddress(email)
+	return err == nil && address.Address == email
+}
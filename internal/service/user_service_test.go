@@ -0,0 +1,231 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/crypto/bcrypt"
+
+	"events/internal/domain"
+	mock_repository "events/internal/repository/mocks"
+	"events/internal/service"
+	"events/pkg/lib/jwt"
+)
+
+var userSettings = service.UserSettings{
+	TokenSecret:       []byte("test-secret"),
+	Issuer:            "events",
+	AccessTokenTTL:    15 * time.Minute,
+	RefreshTokenTTL:   24 * time.Hour,
+	ResetTokenTTL:     time.Hour,
+	MinPasswordLength: 8,
+	PasswordCost:      bcrypt.MinCost,
+}
+
+type recordingNotifier struct {
+	notifications []domain.Notification
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, notification domain.Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func TestRegister(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name       string
+		request    domain.RegisterRequest
+		wantFields []string
+		wantErr    error
+	}{
+		{
+			name:    "Valid",
+			request: domain.RegisterRequest{Email: " Aylar@Example.com ", Name: "Aylar", Password: "correct horse"},
+		},
+		{
+			name:       "Invalid email and short password",
+			request:    domain.RegisterRequest{Email: "aylar", Password: "short"},
+			wantFields: []string{"email", "password"},
+		},
+		{
+			name:    "Email taken",
+			request: domain.RegisterRequest{Email: "aylar@example.com", Password: "correct horse"},
+			wantErr: domain.ErrEmailTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := mock_repository.NewMockUserRepository(ctrl)
+			if tt.wantFields == nil {
+				userRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(user *domain.User) (*domain.User, error) {
+					if tt.wantErr != nil {
+						return nil, tt.wantErr
+					}
+					return user, nil
+				})
+			}
+
+			userService := service.NewUserService(userRepo, nil, nil, userSettings)
+
+			user, err := userService.Register(&tt.request)
+			if tt.wantFields != nil {
+				var validationErr *domain.ValidationError
+				if assert.True(t, errors.As(err, &validationErr)) {
+					fields := make([]string, 0, len(validationErr.Fields))
+					for _, field := range validationErr.Fields {
+						fields = append(fields, field.Field)
+					}
+					assert.Equal(t, tt.wantFields, fields)
+				}
+				return
+			}
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "aylar@example.com", user.Email)
+			assert.NotEqual(t, tt.request.Password, user.PasswordHash)
+			assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(tt.request.Password)))
+		})
+	}
+}
+
+func TestLoginAndRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	require.NoError(t, err)
+	user := &domain.User{ID: primitive.NewObjectID(), Email: "aylar@example.com", Name: "Aylar", PasswordHash: string(hash)}
+
+	userRepo := mock_repository.NewMockUserRepository(ctrl)
+	tokenRepo := mock_repository.NewMockUserTokenRepository(ctrl)
+	userService := service.NewUserService(userRepo, tokenRepo, nil, userSettings)
+
+	var issued *domain.UserToken
+	tokenRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(token *domain.UserToken) error {
+		issued = token
+		return nil
+	}).AnyTimes()
+
+	t.Run("Wrong password", func(t *testing.T) {
+		userRepo.EXPECT().GetByEmail("aylar@example.com").Return(user, nil)
+
+		_, err := userService.Login(&domain.LoginRequest{Email: "aylar@example.com", Password: "wrong horse"})
+		assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	t.Run("Unknown email", func(t *testing.T) {
+		userRepo.EXPECT().GetByEmail("nobody@example.com").Return(nil, domain.ErrUserNotFound)
+
+		_, err := userService.Login(&domain.LoginRequest{Email: "nobody@example.com", Password: "correct horse"})
+		assert.ErrorIs(t, err, domain.ErrInvalidCredentials)
+	})
+
+	userRepo.EXPECT().GetByEmail("aylar@example.com").Return(user, nil)
+	tokens, err := userService.Login(&domain.LoginRequest{Email: "Aylar@example.com", Password: "correct horse"})
+	require.NoError(t, err)
+	first := issued
+
+	t.Run("Access token is verified like any bearer token", func(t *testing.T) {
+		verifier := jwt.NewVerifier(jwt.Keys{HMAC: userSettings.TokenSecret}, "events", "", 0)
+
+		claims, err := verifier.Verify(tokens.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, "user:"+user.ID.Hex(), claims.Subject)
+		assert.Equal(t, 900, tokens.ExpiresIn)
+		assert.NotContains(t, first.Hash, tokens.RefreshToken)
+	})
+
+	t.Run("Refresh rotates the token within its family", func(t *testing.T) {
+		used := *first
+		tokenRepo.EXPECT().Use(domain.UserTokenRefresh, first.Hash, gomock.Any()).Return(&used, nil)
+		userRepo.EXPECT().GetByID(user.ID).Return(user, nil)
+
+		refreshed, err := userService.Refresh(tokens.RefreshToken)
+		require.NoError(t, err)
+		assert.NotEqual(t, tokens.RefreshToken, refreshed.RefreshToken)
+		assert.Equal(t, first.Family, issued.Family)
+	})
+
+	t.Run("Reuse revokes the family", func(t *testing.T) {
+		usedAt := time.Now()
+		used := *first
+		used.UsedAt = &usedAt
+		tokenRepo.EXPECT().Use(domain.UserTokenRefresh, first.Hash, gomock.Any()).Return(nil, domain.ErrTokenNotFound)
+		tokenRepo.EXPECT().GetByHash(domain.UserTokenRefresh, first.Hash).Return(&used, nil)
+		tokenRepo.EXPECT().RevokeFamily(first.Family, gomock.Any()).Return(nil)
+
+		_, err := userService.Refresh(tokens.RefreshToken)
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+}
+
+func TestPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	user := &domain.User{ID: primitive.NewObjectID(), Email: "aylar@example.com"}
+
+	userRepo := mock_repository.NewMockUserRepository(ctrl)
+	tokenRepo := mock_repository.NewMockUserTokenRepository(ctrl)
+	notifier := &recordingNotifier{}
+	settings := userSettings
+	settings.ResetURL = "https://tickets.example.com/reset?token="
+	userService := service.NewUserService(userRepo, tokenRepo, notifier, settings)
+
+	t.Run("Unknown email is ignored", func(t *testing.T) {
+		userRepo.EXPECT().GetByEmail("nobody@example.com").Return(nil, domain.ErrUserNotFound)
+
+		assert.NoError(t, userService.ForgotPassword(context.Background(), &domain.ForgotPasswordRequest{Email: "nobody@example.com"}))
+		assert.Empty(t, notifier.notifications)
+	})
+
+	var stored *domain.UserToken
+	userRepo.EXPECT().GetByEmail("aylar@example.com").Return(user, nil)
+	tokenRepo.EXPECT().RevokeUserTokens(user.ID, domain.UserTokenPasswordReset, gomock.Any()).Return(nil)
+	tokenRepo.EXPECT().Create(gomock.Any()).DoAndReturn(func(token *domain.UserToken) error {
+		stored = token
+		return nil
+	})
+
+	require.NoError(t, userService.ForgotPassword(context.Background(), &domain.ForgotPasswordRequest{Email: "aylar@example.com"}))
+	require.Len(t, notifier.notifications, 1)
+	assert.Equal(t, "aylar@example.com", notifier.notifications[0].To)
+
+	body := notifier.notifications[0].Body
+	start := strings.Index(body, settings.ResetURL) + len(settings.ResetURL)
+	secret := strings.Fields(body[start:])[0]
+
+	t.Run("Reset sets the password and ends every session", func(t *testing.T) {
+		used := *stored
+		tokenRepo.EXPECT().Use(domain.UserTokenPasswordReset, stored.Hash, gomock.Any()).Return(&used, nil)
+		userRepo.EXPECT().UpdatePassword(user.ID, gomock.Any()).DoAndReturn(func(_ primitive.ObjectID, hash string) error {
+			assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte("battery staple")))
+			return nil
+		})
+		tokenRepo.EXPECT().RevokeUserTokens(user.ID, domain.UserTokenRefresh, gomock.Any()).Return(nil)
+
+		assert.NoError(t, userService.ResetPassword(&domain.ResetPasswordRequest{Token: secret, Password: "battery staple"}))
+	})
+
+	t.Run("Used or expired token", func(t *testing.T) {
+		tokenRepo.EXPECT().Use(domain.UserTokenPasswordReset, stored.Hash, gomock.Any()).Return(nil, domain.ErrTokenNotFound)
+
+		err := userService.ResetPassword(&domain.ResetPasswordRequest{Token: secret, Password: "battery staple"})
+		assert.ErrorIs(t, err, domain.ErrInvalidToken)
+	})
+}
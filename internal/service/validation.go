@@ -0,0 +1,189 @@
+package service
+
+import (
+	"events/internal/domain"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	maxNameLength        = 200
+	maxDescriptionLength = 5000
+	maxLabelLength       = 50 // Tags, categories and other short labels
+	maxLabels            = 30
+	maxMediaItems        = 20
+	maxEventDuration     = 24 * 60 // Minutes
+	openingHoursLayout   = "15:04"
+)
+
+// firstReleaseYear predates every film, dates before it are data entry
+// errors. Release dates may lie at most maxReleaseAhead in the future.
+const (
+	firstReleaseYear = 1888
+	maxReleaseAhead  = 10 * 365 * 24 * time.Hour
+)
+
+// validator collects the field errors of one request. Its checks never stop
+// early, so that a client learns about every bad field at once.
+type validator struct {
+	fields []domain.FieldError
+}
+
+func (v *validator) add(field, message string, args ...interface{}) {
+	v.fields = append(v.fields, domain.FieldError{Field: field, Message: fmt.Sprintf(message, args...)})
+}
+
+func (v *validator) check(ok bool, field, message string, args ...interface{}) {
+	if !ok {
+		v.add(field, message, args...)
+	}
+}
+
+func (v *validator) err() error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+
+	return &domain.ValidationError{Fields: v.fields}
+}
+
+func (v *validator) required(field, value string) {
+	v.check(value != "", field, "is required")
+}
+
+func (v *validator) maxLength(field, value string, max int) {
+	v.check(len([]rune(value)) <= max, field, "must be at most %d characters", max)
+}
+
+// url accepts an empty value, set values must be absolute http(s) URLs.
+func (v *validator) url(field, value string) {
+	if value == "" {
+		return
+	}
+
+	parsed, err := url.Parse(value)
+	v.check(err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != "",
+		field, "must be an http or https URL")
+}
+
+// labels checks a list of short, unique, non-empty values such as tags.
+func (v *validator) labels(field string, values []string, max int) {
+	v.check(len(values) <= max, field, "must have at most %d items", max)
+
+	seen := make(map[string]bool, len(values))
+	for i, value := range values {
+		itemField := fmt.Sprintf("%s[%d]", field, i)
+		v.required(itemField, value)
+		v.maxLength(itemField, value, maxLabelLength)
+		v.check(!seen[value], itemField, "is a duplicate")
+		seen[value] = true
+	}
+}
+
+// validateEvent checks the fields every kind shares and then the fields of
+// the kind itself. previous is the stored status of an existing event, the
+// status may only change in the ways the publishing workflow allows.
+func validateEvent(event domain.Event, previous domain.PublicationStatus) error {
+	v := &validator{}
+	base := event.Base()
+
+	v.required("name", base.Name)
+	v.maxLength("name", base.Name, maxNameLength)
+	v.maxLength("description", base.Description, maxDescriptionLength)
+	v.url("cover", base.Cover)
+	v.check(base.Duration <= maxEventDuration, "duration", "must be at most %d minutes", maxEventDuration)
+	v.labels("categories", base.Categories, maxLabels)
+	v.labels("tags", base.Tags, maxLabels)
+
+	v.check(len(base.Media) <= maxMediaItems, "media", "must have at most %d items", maxMediaItems)
+	for i, media := range base.Media {
+		field := fmt.Sprintf("media[%d]", i)
+		v.required(field, media)
+		v.url(field, media)
+	}
+
+	validatePublication(v, base, previous)
+
+	switch event := event.(type) {
+	case *domain.Movie:
+		validateMovie(v, event)
+	case *domain.Concert:
+		validateConcert(v, event)
+	case *domain.Exhibition:
+		validateExhibition(v, event)
+	case *domain.SportEvent:
+		validateSportEvent(v, event)
+	}
+
+	return v.err()
+}
+
+func validatePublication(v *validator, base *domain.EventBase, previous domain.PublicationStatus) {
+	if !base.Status.IsValid() {
+		v.add("status", "must be one of draft, scheduled, published or archived")
+	} else if previous != "" && !previous.CanChangeTo(base.Status) {
+		v.add("status", "cannot change from %s to %s", previous, base.Status)
+	}
+
+	if base.Status == domain.StatusScheduled {
+		v.check(base.PublishAt != nil, "publishAt", "is required for scheduled events")
+	}
+	if base.PublishAt != nil && base.UnpublishAt != nil {
+		v.check(base.UnpublishAt.After(*base.PublishAt), "unpublishAt", "must be after publishAt")
+	}
+}
+
+func validateMovie(v *validator, movie *domain.Movie) {
+	v.maxLength("originalName", movie.OriginalName, maxNameLength)
+
+	if movie.ReleaseDate.IsZero() {
+		v.add("releaseDate", "is required")
+		return
+	}
+	v.check(movie.ReleaseDate.Year() >= firstReleaseYear && movie.ReleaseDate.Before(time.Now().Add(maxReleaseAhead)),
+		"releaseDate", "must be between %d and ten years from now", firstReleaseYear)
+}
+
+func validateConcert(v *validator, concert *domain.Concert) {
+	for i, performer := range concert.Lineup {
+		field := fmt.Sprintf("lineup[%d]", i)
+		v.required(field+".name", performer.Name)
+		v.maxLength(field+".name", performer.Name, maxNameLength)
+		v.maxLength(field+".role", performer.Role, maxLabelLength)
+		v.url(field+".image", performer.Image)
+	}
+}
+
+func validateExhibition(v *validator, exhibition *domain.Exhibition) {
+	v.check(!exhibition.OpenFrom.IsZero(), "openFrom", "is required")
+	v.check(!exhibition.OpenUntil.IsZero(), "openUntil", "is required")
+	if !exhibition.OpenFrom.IsZero() && !exhibition.OpenUntil.IsZero() {
+		v.check(!exhibition.OpenUntil.Before(exhibition.OpenFrom), "openUntil", "must not be before openFrom")
+	}
+
+	days := map[time.Weekday]bool{}
+	for i, hours := range exhibition.OpeningHours {
+		field := fmt.Sprintf("openingHours[%d]", i)
+
+		if hours.Day < time.Sunday || hours.Day > time.Saturday {
+			v.add(field+".day", "must be a weekday from 0 (Sunday) to 6 (Saturday)")
+		} else {
+			v.check(!days[hours.Day], field+".day", "is a duplicate")
+			days[hours.Day] = true
+		}
+
+		opens, opensErr := time.Parse(openingHoursLayout, hours.Opens)
+		closes, closesErr := time.Parse(openingHoursLayout, hours.Closes)
+		v.check(opensErr == nil, field+".opens", "must be a time in HH:MM format")
+		v.check(closesErr == nil, field+".closes", "must be a time in HH:MM format")
+		if opensErr == nil && closesErr == nil {
+			v.check(closes.After(opens), field+".closes", "must be after opens")
+		}
+	}
+}
+
+func validateSportEvent(v *validator, event *domain.SportEvent) {
+	v.labels("teams", event.Teams, maxLabels)
+	v.maxLength("league", event.League, maxNameLength)
+}
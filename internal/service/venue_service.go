@@ -0,0 +1,154 @@
+package service
+
+import (
+	"events/internal/domain"
+	repository "events/internal/repository/interfaces"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type VenueService struct {
+	VenueRepository repository.VenueRepository
+	HallRepository  repository.HallRepository
+}
+
+func NewVenueService(venueRepository repository.VenueRepository, hallRepository repository.HallRepository) *VenueService {
+	return &VenueService{
+		VenueRepository: venueRepository,
+		HallRepository:  hallRepository,
+	}
+}
+
+func (s *VenueService) GetAllVenues(sort domain.Sort, page, pageSize int) ([]*domain.GetVenueResponse, error) {
+	return s.VenueRepository.GetAllVenues(sort, page, pageSize)
+}
+
+func (s *VenueService) GetTotalVenuesCount() (int, error) {
+	return s.VenueRepository.GetTotalVenuesCount()
+}
+
+func (s *VenueService) GetVenueByID(id primitive.ObjectID) (*domain.GetVenueResponse, error) {
+	return s.VenueRepository.GetVenueByID(id)
+}
+
+func (s *VenueService) CreateVenue(request *domain.CreateVenueRequest) (*domain.CreateVenueResponse, error) {
+	if !request.Location.IsValid() {
+		return nil, domain.ErrInvalidLocation
+	}
+
+	return s.VenueRepository.CreateVenue(request)
+}
+
+func (s *VenueService) UpdateVenue(id primitive.ObjectID, request *domain.UpdateVenueRequest) (*domain.UpdateVenueResponse, error) {
+	if !request.Location.IsValid() {
+		return nil, domain.ErrInvalidLocation
+	}
+
+	return s.VenueRepository.UpdateVenue(id, request)
+}
+
+func (s *VenueService) DeleteVenue(id primitive.ObjectID) error {
+	if err := s.VenueRepository.DeleteVenue(id); err != nil {
+		return err
+	}
+
+	return s.HallRepository.DeleteHallsByVenue(id)
+}
+
+func (s *VenueService) GetHallsByVenue(venueID primitive.ObjectID) ([]*domain.GetHallResponse, error) {
+	if err := s.venueExists(venueID); err != nil {
+		return nil, err
+	}
+
+	return s.HallRepository.GetHallsByVenue(venueID)
+}
+
+func (s *VenueService) GetHall(venueID, hallID primitive.ObjectID) (*domain.GetHallResponse, error) {
+	hall, err := s.HallRepository.GetHallByID(hallID)
+	if err != nil {
+		return nil, err
+	}
+
+	if hall == nil || hall.VenueID != venueID {
+		return nil, domain.ErrHallNotFound
+	}
+
+	return hall, nil
+}
+
+func (s *VenueService) CreateHall(venueID primitive.ObjectID, request *domain.CreateHallRequest) (*domain.CreateHallResponse, error) {
+	if err := s.venueExists(venueID); err != nil {
+		return nil, err
+	}
+
+	if err := validateSeatMap(request.SeatCategories, request.SeatMap); err != nil {
+		return nil, err
+	}
+
+	return s.HallRepository.CreateHall(venueID, request)
+}
+
+func (s *VenueService) UpdateHall(venueID, hallID primitive.ObjectID, request *domain.UpdateHallRequest) (*domain.UpdateHallResponse, error) {
+	if _, err := s.GetHall(venueID, hallID); err != nil {
+		return nil, err
+	}
+
+	if err := validateSeatMap(request.SeatCategories, request.SeatMap); err != nil {
+		return nil, err
+	}
+
+	return s.HallRepository.UpdateHall(hallID, request)
+}
+
+func (s *VenueService) DeleteHall(venueID, hallID primitive.ObjectID) error {
+	if _, err := s.GetHall(venueID, hallID); err != nil {
+		return err
+	}
+
+	return s.HallRepository.DeleteHall(hallID)
+}
+
+func (s *VenueService) venueExists(id primitive.ObjectID) error {
+	venue, err := s.VenueRepository.GetVenueByID(id)
+	if err != nil {
+		return err
+	}
+
+	if venue == nil {
+		return domain.ErrVenueNotFound
+	}
+
+	return nil
+}
+
+// validateSeatMap makes sure every seat is unique within the hall and belongs
+// to one of the declared seat categories. Row labels may not contain a dash
+// since it separates the row from the seat number in seat keys.
+func validateSeatMap(categories []domain.SeatCategory, seatMap domain.SeatMap) error {
+	known := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		if category.Code == "" || known[category.Code] {
+			return domain.ErrInvalidSeatMap
+		}
+		known[category.Code] = true
+	}
+
+	rows := make(map[string]bool, len(seatMap.Rows))
+	for _, row := range seatMap.Rows {
+		if row.Label == "" || strings.Contains(row.Label, "-") || rows[row.Label] {
+			return domain.ErrInvalidSeatMap
+		}
+		rows[row.Label] = true
+
+		seats := make(map[string]bool, len(row.Seats))
+		for _, seat := range row.Seats {
+			if seat.Number == "" || seats[seat.Number] || !known[seat.Category] {
+				return domain.ErrInvalidSeatMap
+			}
+			seats[seat.Number] = true
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"events/internal/domain"
+	mock_repository "events/internal/repository/mocks"
+	"events/internal/service"
+)
+
+func TestCreateHall(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	venueID := primitive.NewObjectID()
+	categories := []domain.SeatCategory{{Code: "standard", Name: "Standard"}, {Code: "vip", Name: "VIP"}}
+
+	tests := []struct {
+		name    string
+		request *domain.CreateHallRequest
+		wantErr error
+	}{
+		{
+			name: "Valid seat map",
+			request: &domain.CreateHallRequest{
+				Name:           "Hall 1",
+				SeatCategories: categories,
+				SeatMap: domain.SeatMap{Rows: []domain.SeatRow{
+					{Label: "A", Seats: []domain.Seat{{Number: "1", Category: "vip"}, {Number: "2", Category: "vip"}}},
+					{Label: "B", Seats: []domain.Seat{{Number: "1", Category: "standard"}}},
+				}},
+			},
+		},
+		{
+			name: "Duplicate seat in a row",
+			request: &domain.CreateHallRequest{
+				Name:           "Hall 1",
+				SeatCategories: categories,
+				SeatMap: domain.SeatMap{Rows: []domain.SeatRow{
+					{Label: "A", Seats: []domain.Seat{{Number: "1", Category: "vip"}, {Number: "1", Category: "vip"}}},
+				}},
+			},
+			wantErr: domain.ErrInvalidSeatMap,
+		},
+		{
+			name: "Undeclared seat category",
+			request: &domain.CreateHallRequest{
+				Name:           "Hall 1",
+				SeatCategories: categories,
+				SeatMap: domain.SeatMap{Rows: []domain.SeatRow{
+					{Label: "A", Seats: []domain.Seat{{Number: "1", Category: "balcony"}}},
+				}},
+			},
+			wantErr: domain.ErrInvalidSeatMap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			venueRepo := mock_repository.NewMockVenueRepository(ctrl)
+			hallRepo := mock_repository.NewMockHallRepository(ctrl)
+
+			venueRepo.EXPECT().GetVenueByID(venueID).Return(&domain.GetVenueResponse{ID: venueID}, nil)
+			if tt.wantErr == nil {
+				hallRepo.EXPECT().CreateHall(venueID, tt.request).Return(&domain.CreateHallResponse{
+					ID:       primitive.NewObjectID(),
+					VenueID:  venueID,
+					Name:     tt.request.Name,
+					Capacity: tt.request.SeatMap.Capacity(),
+				}, nil)
+			}
+
+			venueService := service.NewVenueService(venueRepo, hallRepo)
+
+			got, err := venueService.CreateHall(venueID, tt.request)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, 3, got.Capacity)
+		})
+	}
+}
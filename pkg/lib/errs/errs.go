@@ -2,13 +2,59 @@ package errs
 
 const (
 	InvalidRequestFormat = "Invalid request format"
-	InvalidMovieID       = "Invalid movie id"
-	InvalidPerformanceID = "Invalid performance id"
-	MovieNotFound        = "Movie not found"
-	PerformanceNotFound  = "Performance not found"
+	InvalidEventID       = "Invalid %s id"
+	InvalidShowtimeID    = "Invalid showtime id"
+	InvalidVenueID       = "Invalid venue id"
+	InvalidHallID        = "Invalid hall id"
+	InvalidBookingID     = "Invalid booking id"
+	EventKindNotFound    = "%s not found"
+	ShowtimeNotFound     = "Showtime not found"
+	EventNotFound        = "Event not found"
+	VenueNotFound        = "Venue not found"
+	HallNotFound         = "Hall not found"
+	BookingNotFound      = "Booking not found"
 	InternalServerError  = "Internal server error"
 	InvalidRequestBody   = "Invalid request body"
 	InvalidPage          = "Invalid page"
 	InvalidPageSize      = "Invalid page size"
+	InvalidCursor        = "Invalid cursor"
+	InvalidLimit         = "Invalid limit"
+	InvalidTimeRange     = "Invalid time range"
+	InvalidEventType     = "Invalid event type"
+	InvalidShowtime      = "Showtime must end after it starts"
+	UnknownDuration      = "Event duration is unknown, endTime is required"
+	InvalidPriceTier     = "Price tiers require a seat category of the hall and a non-negative price"
+	InvalidStatus        = "Invalid status"
+	InvalidLocation      = "Location must be a GeoJSON point with valid coordinates"
+	InvalidSeatMap       = "Seat map rows and seats must be unique and use declared seat categories"
+	InvalidSeats         = "Seats must be unique, exist in the hall and have a price"
+	SeatsUnavailable     = "Some of the selected seats are already taken"
+	ShowtimeNotBookable  = "Showtime is not open for booking"
+	HoldExpired          = "Seat hold has expired"
+	BookingNotHeld       = "Booking is not an active hold"
+	BookingNotCancelable = "Booking can no longer be cancelled"
+	MissingBookingToken  = "Booking token is required"
 	MissingTags          = "Missing tags"
+	InvalidFilter        = "Invalid filter"
+	UnsupportedFilter    = "Filter is not supported for %s events"
+	InvalidSort          = "Invalid sort"
+	SortWithCursor       = "Sort is not supported with cursor pagination"
+	ValidationFailed     = "Validation failed"
+	InvalidPatch         = "Invalid merge patch"
+	UnsupportedPatch     = "Only application/merge-patch+json patches are supported"
+	VersionMismatch      = "Event was modified by someone else, reload it and try again"
+	InvalidRevision      = "Invalid revision"
+	RevisionNotFound     = "Revision not found"
+	Unauthenticated      = "Authentication required"
+	InvalidCredentials   = "Invalid credentials"
+	InvalidAPIKeyID      = "Invalid api key id"
+	APIKeyNotFound       = "API key not found"
+	MissingAPIKeyName    = "API key name is required"
+	Forbidden            = "You are not allowed to do this"
+	InvalidRoleID        = "Invalid role assignment id"
+	InvalidRole          = "Role assignments need a subject, a known role and known event types"
+	RoleNotFound         = "Role assignment not found"
+	UserNotFound         = "User not found"
+	EmailTaken           = "Email is already registered"
+	InvalidToken         = "Token is invalid or has expired"
 )
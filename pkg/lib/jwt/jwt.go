@@ -0,0 +1,254 @@
+// Package jwt verifies JSON Web Tokens signed with HS256 or RS256 and signs
+// HS256 tokens. It covers what the API needs: compact tokens, the
+// registered time claims and issuer and audience checks.
+package jwt
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	ErrMalformed            = errors.New("malformed token")
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrUnknownKey           = errors.New("unknown signing key")
+	ErrInvalidSignature     = errors.New("invalid token signature")
+	ErrMissingExpiry        = errors.New("token has no expiry")
+	ErrExpired              = errors.New("token has expired")
+	ErrNotYetValid          = errors.New("token is not valid yet")
+	ErrInvalidIssuer        = errors.New("invalid token issuer")
+	ErrInvalidAudience      = errors.New("invalid token audience")
+)
+
+const (
+	HS256 = "HS256"
+	RS256 = "RS256"
+)
+
+// Claims are the registered claims the API reads, plus the display name.
+// Times are seconds since the Unix epoch.
+type Claims struct {
+	Subject   string   `json:"sub"`
+	Name      string   `json:"name,omitempty"`
+	Issuer    string   `json:"iss,omitempty"`
+	Audience  Audience `json:"aud,omitempty"`
+	ExpiresAt int64    `json:"exp,omitempty"`
+	NotBefore int64    `json:"nbf,omitempty"`
+	IssuedAt  int64    `json:"iat,omitempty"`
+	ID        string   `json:"jti,omitempty"`
+}
+
+// Audience is a single audience or a list of them.
+type Audience []string
+
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+
+	return nil
+}
+
+func (a Audience) contains(audience string) bool {
+	for _, value := range a {
+		if value == audience {
+			return true
+		}
+	}
+	return false
+}
+
+// Keys are the keys tokens may be signed with. RSA keys are looked up by
+// the key ID of the token; a token without one may use the only RSA key.
+type Keys struct {
+	HMAC []byte
+	RSA  map[string]*rsa.PublicKey
+}
+
+func (k Keys) IsEmpty() bool {
+	return len(k.HMAC) == 0 && len(k.RSA) == 0
+}
+
+// Verifier checks signatures and claims. An empty issuer or audience is
+// not checked; leeway allows for clock skew between issuer and API.
+type Verifier struct {
+	keys     Keys
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+func NewVerifier(keys Keys, issuer, audience string, leeway time.Duration) *Verifier {
+	return &Verifier{
+		keys:     keys,
+		issuer:   issuer,
+		audience: audience,
+		leeway:   leeway,
+		now:      time.Now,
+	}
+}
+
+type header struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid,omitempty"`
+	Type      string `json:"typ,omitempty"`
+}
+
+// Verify returns the claims of a valid token. The algorithm of the token
+// must match the kind of key it is verified with, so that a public RSA key
+// can never be used as an HMAC secret.
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformed
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, ErrMalformed
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrMalformed
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+	if err := v.verifySignature(h, signed, signature); err != nil {
+		return nil, err
+	}
+
+	var claims Claims
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, ErrMalformed
+	}
+
+	if err := v.checkClaims(&claims); err != nil {
+		return nil, err
+	}
+
+	return &claims, nil
+}
+
+func (v *Verifier) verifySignature(h header, signed, signature []byte) error {
+	switch h.Algorithm {
+	case HS256:
+		if len(v.keys.HMAC) == 0 {
+			return ErrUnknownKey
+		}
+		mac := hmac.New(sha256.New, v.keys.HMAC)
+		mac.Write(signed)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return ErrInvalidSignature
+		}
+		return nil
+
+	case RS256:
+		key := v.rsaKey(h.KeyID)
+		if key == nil {
+			return ErrUnknownKey
+		}
+		digest := sha256.Sum256(signed)
+		if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature); err != nil {
+			return ErrInvalidSignature
+		}
+		return nil
+	}
+
+	return ErrUnsupportedAlgorithm
+}
+
+func (v *Verifier) rsaKey(keyID string) *rsa.PublicKey {
+	if key, ok := v.keys.RSA[keyID]; ok {
+		return key
+	}
+
+	if keyID == "" && len(v.keys.RSA) == 1 {
+		for _, key := range v.keys.RSA {
+			return key
+		}
+	}
+
+	return nil
+}
+
+func (v *Verifier) checkClaims(claims *Claims) error {
+	now := v.now()
+
+	if claims.ExpiresAt == 0 {
+		return ErrMissingExpiry
+	}
+	if !now.Before(time.Unix(claims.ExpiresAt, 0).Add(v.leeway)) {
+		return ErrExpired
+	}
+	if claims.NotBefore != 0 && now.Add(v.leeway).Before(time.Unix(claims.NotBefore, 0)) {
+		return ErrNotYetValid
+	}
+
+	if v.issuer != "" && claims.Issuer != v.issuer {
+		return ErrInvalidIssuer
+	}
+	if v.audience != "" && !claims.Audience.contains(v.audience) {
+		return ErrInvalidAudience
+	}
+
+	return nil
+}
+
+// Sign returns an HS256 token carrying claims.
+func Sign(claims *Claims, secret []byte) (string, error) {
+	if len(secret) == 0 {
+		return "", ErrUnknownKey
+	}
+
+	h, err := encodeSegment(header{Algorithm: HS256, Type: "JWT"})
+	if err != nil {
+		return "", err
+	}
+	payload, err := encodeSegment(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := h + "." + payload
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+func encodeSegment(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	return decoder.Decode(v)
+}
@@ -0,0 +1,218 @@
+package jwt_test
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"events/pkg/lib/jwt"
+)
+
+var secret = []byte("test-secret")
+
+func encode(t *testing.T, v interface{}) string {
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, header, claims map[string]interface{}) string {
+	unsigned := encode(t, header) + "." + encode(t, claims)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, header, claims map[string]interface{}) string {
+	unsigned := encode(t, header) + "." + encode(t, claims)
+	digest := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	verifier := jwt.NewVerifier(jwt.Keys{
+		HMAC: secret,
+		RSA:  map[string]*rsa.PublicKey{"main": &rsaKey.PublicKey},
+	}, "https://auth.example.com", "events-api", time.Minute)
+
+	now := time.Now().Unix()
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"sub": "editor-1",
+			"iss": "https://auth.example.com",
+			"aud": "events-api",
+			"exp": now + 3600,
+		}
+		for key, value := range overrides {
+			c[key] = value
+		}
+		return c
+	}
+	hs256 := map[string]interface{}{"alg": "HS256", "typ": "JWT"}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{
+			name:  "HS256",
+			token: signHS256(t, hs256, claims(nil)),
+		},
+		{
+			name:  "RS256 with key ID",
+			token: signRS256(t, rsaKey, map[string]interface{}{"alg": "RS256", "kid": "main"}, claims(nil)),
+		},
+		{
+			name:  "RS256 without key ID uses the only key",
+			token: signRS256(t, rsaKey, map[string]interface{}{"alg": "RS256"}, claims(nil)),
+		},
+		{
+			name:  "Audience list",
+			token: signHS256(t, hs256, claims(map[string]interface{}{"aud": []string{"other", "events-api"}})),
+		},
+		{
+			name:    "Unknown key ID",
+			token:   signRS256(t, rsaKey, map[string]interface{}{"alg": "RS256", "kid": "old"}, claims(nil)),
+			wantErr: jwt.ErrUnknownKey,
+		},
+		{
+			name:    "Unsigned",
+			token:   encode(t, map[string]interface{}{"alg": "none"}) + "." + encode(t, claims(nil)) + ".",
+			wantErr: jwt.ErrUnsupportedAlgorithm,
+		},
+		{
+			name: "Tampered claims",
+			token: func() string {
+				token := signHS256(t, hs256, claims(nil))
+				parts := strings.Split(token, ".")
+				parts[1] = encode(t, claims(map[string]interface{}{"sub": "admin"}))
+				return strings.Join(parts, ".")
+			}(),
+			wantErr: jwt.ErrInvalidSignature,
+		},
+		{
+			name:    "Expired beyond leeway",
+			token:   signHS256(t, hs256, claims(map[string]interface{}{"exp": now - 120})),
+			wantErr: jwt.ErrExpired,
+		},
+		{
+			name:  "Expired within leeway",
+			token: signHS256(t, hs256, claims(map[string]interface{}{"exp": now - 30})),
+		},
+		{
+			name:    "No expiry",
+			token:   signHS256(t, hs256, claims(map[string]interface{}{"exp": nil})),
+			wantErr: jwt.ErrMissingExpiry,
+		},
+		{
+			name:    "Not valid yet",
+			token:   signHS256(t, hs256, claims(map[string]interface{}{"nbf": now + 600})),
+			wantErr: jwt.ErrNotYetValid,
+		},
+		{
+			name:    "Wrong issuer",
+			token:   signHS256(t, hs256, claims(map[string]interface{}{"iss": "https://evil.example.com"})),
+			wantErr: jwt.ErrInvalidIssuer,
+		},
+		{
+			name:    "Wrong audience",
+			token:   signHS256(t, hs256, claims(map[string]interface{}{"aud": "other"})),
+			wantErr: jwt.ErrInvalidAudience,
+		},
+		{
+			name:    "Not a JWT",
+			token:   "evk_abc",
+			wantErr: jwt.ErrMalformed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := verifier.Verify(tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "editor-1", got.Subject)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	verifier := jwt.NewVerifier(jwt.Keys{HMAC: secret}, "events", "events-api", 0)
+
+	token, err := jwt.Sign(&jwt.Claims{
+		Subject:   "user:1",
+		Name:      "Aylar",
+		Issuer:    "events",
+		Audience:  jwt.Audience{"events-api"},
+		ExpiresAt: time.Now().Add(time.Minute).Unix(),
+	}, secret)
+	require.NoError(t, err)
+
+	claims, err := verifier.Verify(token)
+	require.NoError(t, err)
+	assert.Equal(t, "user:1", claims.Subject)
+	assert.Equal(t, "Aylar", claims.Name)
+
+	_, err = jwt.Sign(&jwt.Claims{Subject: "user:1"}, nil)
+	assert.ErrorIs(t, err, jwt.ErrUnknownKey)
+}
+
+func TestRSAKeyIsNotAnHMACSecret(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// Without an HMAC secret, an HS256 token signed with anything, such as
+	// the public key, is rejected.
+	verifier := jwt.NewVerifier(jwt.Keys{RSA: map[string]*rsa.PublicKey{"main": &rsaKey.PublicKey}}, "", "", 0)
+
+	token := signHS256(t, map[string]interface{}{"alg": "HS256", "kid": "main"}, map[string]interface{}{
+		"sub": "editor-1",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	_, err = verifier.Verify(token)
+	assert.ErrorIs(t, err, jwt.ErrUnknownKey)
+}
+
+func TestParseJWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwks := fmt.Sprintf(`{"keys": [
+		{"kty": "RSA", "kid": "main", "use": "sig", "alg": "RS256", "n": %q, "e": %q},
+		{"kty": "RSA", "kid": "encryption", "use": "enc", "n": %q, "e": "AQAB"},
+		{"kty": "EC", "kid": "ec", "crv": "P-256", "x": "", "y": ""}
+	]}`,
+		base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+		base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+	)
+
+	keys, err := jwt.ParseJWKS([]byte(jwks))
+	require.NoError(t, err)
+
+	assert.Len(t, keys, 1)
+	assert.True(t, rsaKey.PublicKey.Equal(keys["main"]))
+}
@@ -0,0 +1,86 @@
+// Package mergepatch applies JSON merge patches as described in RFC 7396:
+// members of the patch replace those of the document, null members remove
+// them, and nested objects are merged the same way.
+package mergepatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+var ErrNotObject = errors.New("merge patch must be a JSON object")
+
+// Apply returns document with patch merged into it.
+func Apply(document, patch []byte) ([]byte, error) {
+	target, err := decode(document)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := decode(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(merge(target, changes))
+}
+
+// Keys returns the top-level members a patch touches. Patches that are not
+// objects would replace the whole document and are rejected.
+func Keys(patch []byte) ([]string, error) {
+	changes, err := decode(patch)
+	if err != nil {
+		return nil, err
+	}
+
+	object, ok := changes.(map[string]interface{})
+	if !ok {
+		return nil, ErrNotObject
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func merge(target, patch interface{}) interface{} {
+	changes, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	object, ok := target.(map[string]interface{})
+	if !ok {
+		object = map[string]interface{}{}
+	}
+
+	for key, value := range changes {
+		if value == nil {
+			delete(object, key)
+			continue
+		}
+		object[key] = merge(object[key], value)
+	}
+
+	return object
+}
+
+// decode keeps numbers as written, so that large integers survive a merge.
+func decode(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, errors.New("unexpected data after JSON value")
+	}
+
+	return value, nil
+}
@@ -0,0 +1,70 @@
+package mergepatch_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"events/pkg/lib/mergepatch"
+)
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		patch    string
+		want     string
+	}{
+		{
+			name:     "Replaces and adds members",
+			document: `{"name":"Dune","tags":["imax"]}`,
+			patch:    `{"name":"Dune: Part Two","cover":"dune.jpg"}`,
+			want:     `{"cover":"dune.jpg","name":"Dune: Part Two","tags":["imax"]}`,
+		},
+		{
+			name:     "Null removes a member",
+			document: `{"name":"Dune","age":12}`,
+			patch:    `{"age":null}`,
+			want:     `{"name":"Dune"}`,
+		},
+		{
+			name:     "Arrays are replaced, not merged",
+			document: `{"tags":["imax","3d"]}`,
+			patch:    `{"tags":["4dx"]}`,
+			want:     `{"tags":["4dx"]}`,
+		},
+		{
+			name:     "Nested objects are merged",
+			document: `{"venue":{"name":"Ashgabat","city":"Ashgabat"}}`,
+			patch:    `{"venue":{"name":"Turkmenistan Cinema","city":null}}`,
+			want:     `{"venue":{"name":"Turkmenistan Cinema"}}`,
+		},
+		{
+			name:     "Large numbers are kept",
+			document: `{"popularity":9007199254740993}`,
+			patch:    `{}`,
+			want:     `{"popularity":9007199254740993}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mergepatch.Apply([]byte(tt.document), []byte(tt.patch))
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestKeys(t *testing.T) {
+	keys, err := mergepatch.Keys([]byte(`{"name":"Dune","media":null}`))
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"name", "media"}, keys)
+
+	_, err = mergepatch.Keys([]byte(`["name"]`))
+	assert.ErrorIs(t, err, mergepatch.ErrNotObject)
+
+	_, err = mergepatch.Keys([]byte(`{"name":`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,83 @@
+// Package normalize folds names into a lowercase ASCII form so that the same
+// title typed in Turkmen Latin, Turkmen or Russian Cyrillic, with or without
+// diacritics, compares equal.
+package normalize
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// cyrillic follows the Turkmen Latin alphabet, which the later steps reduce
+// to plain ASCII.
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "w", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'җ': "j", 'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l",
+	'м': "m", 'н': "n", 'ң': "ň", 'о': "o", 'ө': "ö", 'п': "p", 'р': "r",
+	'с': "s", 'т': "t", 'у': "u", 'ү': "ü", 'ф': "f", 'х': "h", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e",
+	'ә': "ä", 'ю': "yu", 'я': "ya",
+}
+
+// digraphs are spelled differently by Russian and Turkmen transliteration
+// ("Шрек" is "Shrek" or "Şrek"), they are folded to one letter after the
+// diacritics are gone. v and w are the same Cyrillic letter в.
+var digraphs = strings.NewReplacer(
+	"shch", "s",
+	"zh", "z",
+	"ch", "c",
+	"sh", "s",
+	"kh", "h",
+	"w", "v",
+)
+
+var stripMarks = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+// Name returns the folded form of s: lowercase ASCII letters and digits
+// separated by single spaces.
+func Name(s string) string {
+	var transliterated strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if latin, ok := cyrillic[r]; ok {
+			transliterated.WriteString(latin)
+		} else {
+			transliterated.WriteRune(r)
+		}
+	}
+
+	stripped, _, err := transform.String(stripMarks, transliterated.String())
+	if err != nil {
+		stripped = transliterated.String()
+	}
+
+	words := strings.FieldsFunc(stripped, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9')
+	})
+
+	return digraphs.Replace(strings.Join(words, " "))
+}
+
+// Keys returns every word-aligned suffix of the folded names, so that a
+// prefix query matches the start of any word: "star wars" yields
+// "star vars" and "vars".
+func Keys(names ...string) []string {
+	seen := map[string]bool{}
+	keys := []string{}
+
+	for _, name := range names {
+		words := strings.Fields(Name(name))
+		for i := range words {
+			key := strings.Join(words[i:], " ")
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
+}
@@ -0,0 +1,36 @@
+package normalize
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "Lowercase and punctuation", input: "  Star Wars: Episode IV ", want: "star vars episode iv"},
+		{name: "Latin diacritics", input: "Amélie", want: "amelie"},
+		{name: "Turkmen Latin", input: "Göroğly", want: "gorogly"},
+		{name: "Turkmen Cyrillic", input: "Гөроглы", want: "gorogly"},
+		{name: "Russian transliteration", input: "Шрек", want: "srek"},
+		{name: "Russian and Turkmen Latin agree", input: "Şrek", want: "srek"},
+		{name: "Soft and hard signs", input: "Подъезд", want: "podezd"},
+		{name: "Digits are kept", input: "1+1", want: "1 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Name(tt.input))
+		})
+	}
+}
+
+func TestKeys(t *testing.T) {
+	got := Keys("Star Wars", "Звёздные войны", "Wars")
+
+	assert.Equal(t, []string{"star vars", "vars", "zvyozdnye voyny", "voyny"}, got)
+}
@@ -0,0 +1,44 @@
+package pagination_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	"events/pkg/lib/pagination"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	cursor := pagination.Cursor{ID: primitive.NewObjectID()}
+
+	decoded, err := pagination.DecodeCursor(pagination.EncodeCursor(cursor))
+
+	assert.NoError(t, err)
+	assert.Equal(t, cursor, decoded)
+}
+
+func TestDecodeCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    pagination.Cursor
+		wantErr bool
+	}{
+		{name: "Empty cursor starts from the beginning", value: "", want: pagination.Cursor{}},
+		{name: "Not base64", value: "%%%", wantErr: true},
+		{name: "Not JSON", value: "bm90LWpzb24", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pagination.DecodeCursor(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, pagination.ErrInvalidCursor)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
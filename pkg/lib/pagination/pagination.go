@@ -0,0 +1,56 @@
+package pagination
+
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+)
+
+// Pagination describes one page of a page-numbered list.
+type Pagination struct {
+	CurrentPage int  `json:"current_page"`
+	PrevPage    *int `json:"prev_page"`
+	NextPage    *int `json:"next_page"`
+	FirstPage   *int `json:"first_page"`
+	LastPage    *int `json:"last_page"`
+	PageSize    int  `json:"page_size"`
+	TotalItems  int  `json:"total_items"`
+	TotalPages  int  `json:"total_pages"`
+}
+
+// CursorPagination describes one page of a cursor-paginated list. NextCursor
+// is nil on the last page.
+type CursorPagination struct {
+	PageSize   int     `json:"page_size"`
+	NextCursor *string `json:"next_cursor"`
+}
+
+func New(page, pageSize, totalItems int) Pagination {
+	totalPages := 0
+	if pageSize > 0 {
+		totalPages = (totalItems + pageSize - 1) / pageSize
+	}
+
+	p := Pagination{
+		CurrentPage: page,
+		PageSize:    pageSize,
+		TotalItems:  totalItems,
+		TotalPages:  totalPages,
+	}
+
+	if page > 1 {
+		p.PrevPage = intPtr(page - 1)
+	}
+	if page < totalPages {
+		p.NextPage = intPtr(page + 1)
+	}
+	if totalPages > 0 {
+		p.FirstPage = intPtr(1)
+		p.LastPage = intPtr(totalPages)
+	}
+
+	return p
+}
+
+func intPtr(value int) *int {
+	return &value
+}
@@ -0,0 +1,71 @@
+package pagination_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"events/pkg/lib/pagination"
+)
+
+func intPtr(value int) *int {
+	return &value
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name       string
+		page       int
+		pageSize   int
+		totalItems int
+		want       pagination.Pagination
+	}{
+		{
+			name:       "Middle page",
+			page:       2,
+			pageSize:   10,
+			totalItems: 25,
+			want: pagination.Pagination{
+				CurrentPage: 2,
+				PrevPage:    intPtr(1),
+				NextPage:    intPtr(3),
+				FirstPage:   intPtr(1),
+				LastPage:    intPtr(3),
+				PageSize:    10,
+				TotalItems:  25,
+				TotalPages:  3,
+			},
+		},
+		{
+			name:       "Last page is full",
+			page:       2,
+			pageSize:   10,
+			totalItems: 20,
+			want: pagination.Pagination{
+				CurrentPage: 2,
+				PrevPage:    intPtr(1),
+				FirstPage:   intPtr(1),
+				LastPage:    intPtr(2),
+				PageSize:    10,
+				TotalItems:  20,
+				TotalPages:  2,
+			},
+		},
+		{
+			name:       "No results",
+			page:       1,
+			pageSize:   10,
+			totalItems: 0,
+			want: pagination.Pagination{
+				CurrentPage: 1,
+				PageSize:    10,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pagination.New(tt.page, tt.pageSize, tt.totalItems))
+		})
+	}
+}
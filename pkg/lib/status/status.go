@@ -3,10 +3,14 @@ package status
 import "net/http"
 
 const (
-	BadRequest          = http.StatusBadRequest
-	NotFound            = http.StatusNotFound
-	OK                  = http.StatusOK
-	InternalServerError = http.StatusInternalServerError
-	Forbidden           = http.StatusForbidden
-	Conflict            = http.StatusConflict
+	BadRequest           = http.StatusBadRequest
+	Unauthorized         = http.StatusUnauthorized
+	NotFound             = http.StatusNotFound
+	OK                   = http.StatusOK
+	InternalServerError  = http.StatusInternalServerError
+	Forbidden            = http.StatusForbidden
+	Conflict             = http.StatusConflict
+	UnprocessableEntity  = http.StatusUnprocessableEntity
+	UnsupportedMediaType = http.StatusUnsupportedMediaType
+	PreconditionFailed   = http.StatusPreconditionFailed
 )
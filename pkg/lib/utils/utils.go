@@ -13,16 +13,26 @@ func Err(err error) slog.Attr {
 	}
 }
 
-func RespondWithErrorJSON(w http.ResponseWriter, status int, message string) {
+// FieldError is one entry of the per-field error list of an error response.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// RespondWithErrorJSON writes an error response. Field errors, when given,
+// are listed under "errors".
+func RespondWithErrorJSON(w http.ResponseWriter, status int, message string, fieldErrors ...FieldError) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 
 	jsonError := struct {
-		Status  int    `json:"status"`
-		Message string `json:"message"`
+		Status  int          `json:"status"`
+		Message string       `json:"message"`
+		Errors  []FieldError `json:"errors,omitempty"`
 	}{
 		Status:  status,
 		Message: message,
+		Errors:  fieldErrors,
 	}
 
 	json.NewEncoder(w).Encode(jsonError)